@@ -1,19 +1,24 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stressedbypull/swapi-connector/internal/adapters/http/handlers"
 	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/openapi"
 	"github.com/stressedbypull/swapi-connector/internal/adapters/swapi"
 	"github.com/stressedbypull/swapi-connector/internal/config"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/pagination"
 	"github.com/stressedbypull/swapi-connector/internal/services"
 
 	_ "github.com/stressedbypull/swapi-connector/docs" // Import generated docs
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"github.com/swaggo/swag"
 )
 
 // @title           SWAPI Connector API
@@ -43,24 +48,67 @@ func main() {
 	httpClient := &http.Client{}
 
 	// 2. Adapter layer: SWAPI client implements repository interfaces
-	swapiClient := swapi.NewClient(cfg.SWAPI.BaseURL, httpClient)
+	swapiClient := swapi.NewClient(cfg.SWAPI.BaseURL, httpClient, cfg.SWAPI.FetchConcurrency)
 
 	// 3. Service layer: Business logic
-	peopleService := services.NewPeopleService(swapiClient)
+	relationResolver := services.NewRelationResolver(
+		swapiClient, swapiClient, swapiClient,
+		cfg.Expand.MaxFetches, cfg.Expand.Concurrency,
+	)
+	listSettings := services.ListSettings{
+		PageSize:    cfg.SWAPI.PageSize,
+		DefaultMode: domain.ListMode(cfg.List.DefaultMode),
+	}
+
+	// One cache per complete collection, shared by list, related-name and suggest lookups,
+	// so each collection is crawled from SWAPI once per LIST_COLLECTION_TTL
+	peopleCollection := services.NewCollectionCache(cfg.List.CollectionTTL, swapiClient.APIRetrieveAllPeople)
+	planetsCollection := services.NewCollectionCache(cfg.List.CollectionTTL, swapiClient.FetchAllPlanets)
+	filmsCollection := services.NewCollectionCache(cfg.List.CollectionTTL, swapiClient.FetchAllFilms)
+	speciesCollection := services.NewCollectionCache(cfg.List.CollectionTTL, swapiClient.FetchAllSpecies)
+
+	relatedNames := services.NewRelatedNames(planetsCollection, filmsCollection, speciesCollection)
+	peopleService := services.NewPeopleService(swapiClient, peopleCollection, relationResolver, relatedNames, listSettings)
+	planetService := services.NewPlanetService(swapiClient, planetsCollection, relationResolver, relatedNames, listSettings)
+	filmService := services.NewFilmService(swapiClient)
+	starshipService := services.NewStarshipService(swapiClient)
+	vehicleService := services.NewVehicleService(swapiClient)
+	speciesService := services.NewSpeciesService(swapiClient, speciesCollection)
+	suggestService := services.NewSuggestService(peopleCollection, planetsCollection, filmsCollection, speciesCollection, cfg.Suggest.RefreshInterval)
+
+	// Build the suggestion index in the background and keep it fresh
+	go suggestService.Run(context.Background())
 
 	// 4. Presentation layer: HTTP handlers
 	peopleHandler := handlers.NewPeopleHandler(peopleService)
+	planetHandler := handlers.NewPlanetHandler(planetService)
+	filmHandler := handlers.NewFilmHandler(filmService)
+	starshipHandler := handlers.NewStarshipHandler(starshipService)
+	vehicleHandler := handlers.NewVehicleHandler(vehicleService)
+	speciesHandler := handlers.NewSpeciesHandler(speciesService)
+	suggestHandler := handlers.NewSuggestHandler(suggestService)
+
+	// Cursors are signed, so clients can neither forge positions nor reuse them for other queries
+	cursors := pagination.NewCursorCodec([]byte(cfg.List.CursorSecret))
 
 	// Setup router
 	router := gin.Default()
 
 	// Global middleware
 	router.Use(middleware.CORS(cfg.CORS.AllowedOrigins))
-	router.Use(middleware.PaginationMiddleware())
+	router.Use(middleware.PaginationMiddleware(middleware.PaginationSettings{
+		DefaultPageSize: cfg.SWAPI.PageSize,
+		MinPageSize:     cfg.SWAPI.MinPageSize,
+		MaxPageSize:     cfg.SWAPI.MaxPageSize,
+		Cursors:         cursors,
+
+		TrustForwardedHeaders: cfg.Server.TrustForwardedHeaders,
+	}))
 	router.Use(middleware.QueryMiddleware())
 
-	// Swagger documentation
-	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	// Swagger documentation, with the sort enums derived from the sorter registries
+	openapi.RegisterSortEnums("api", swag.GetSwagger(swag.Name), handlers.SortFieldsByRoute())
+	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler, ginSwagger.InstanceName("api")))
 
 	// Health check
 	router.GET("/ping", healthCheck)
@@ -72,13 +120,48 @@ func main() {
 		people := api.Group("/people")
 		{
 			people.GET("", peopleHandler.ListPeople)
+			people.GET("/:id", peopleHandler.GetPersonByID)
+			people.GET("/:id/films", peopleHandler.ListPersonFilms)
+		}
+
+		// Planets endpoints
+		planets := api.Group("/planets")
+		{
+			planets.GET("", planetHandler.ListPlanets)
+			planets.GET("/:id", planetHandler.GetPlanetByID)
+			planets.GET("/:id/residents", planetHandler.ListPlanetResidents)
+		}
+
+		// Films endpoints
+		films := api.Group("/films")
+		{
+			films.GET("", filmHandler.ListFilms)
+			films.GET("/:id", filmHandler.GetFilmByID)
+		}
+
+		// Starships endpoints
+		starships := api.Group("/starships")
+		{
+			starships.GET("", starshipHandler.ListStarships)
+			starships.GET("/:id", starshipHandler.GetStarshipByID)
+		}
+
+		// Vehicles endpoints
+		vehicles := api.Group("/vehicles")
+		{
+			vehicles.GET("", vehicleHandler.ListVehicles)
+			vehicles.GET("/:id", vehicleHandler.GetVehicleByID)
+		}
+
+		// Species endpoints
+		species := api.Group("/species")
+		{
+			species.GET("", speciesHandler.ListSpecies)
+			species.GET("/:id", speciesHandler.GetSpeciesByID)
 		}
 
-		// Planets endpoints (TODO: implement)
-		// planets := api.Group("/planets")
-		// {
-		// 	planets.GET("", planetsHandler.ListPlanets)
-		// }
+		// Name completion
+		api.GET("/suggest", suggestHandler.Suggest)
 	}
 
 	// Start server
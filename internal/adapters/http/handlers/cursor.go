@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/validation"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/pagination"
+	"github.com/stressedbypull/swapi-connector/internal/search"
+	"github.com/stressedbypull/swapi-connector/internal/sorting"
+)
+
+// cursorPage is the verified position of a cursor-paginated request.
+// The zero value means the request uses page numbers.
+type cursorPage struct {
+	codec *pagination.CursorCodec
+	at    pagination.Cursor // Offset, limit and query digests of this page
+}
+
+// parseCursor validates ?cursor= and ?limit= against the fully built list query q, and
+// switches q to offset pagination when either is present. The first page is requested
+// with ?limit= alone; later pages pass the nextCursor/prevCursor of the previous response
+// together with the same search, filter and sort parameters. A cursor is rejected when it
+// was not signed by this server or was issued for another endpoint, other filters or
+// another sort. On failure the error response is already sent.
+func parseCursor(c *gin.Context, q domain.ListQuery) (domain.ListQuery, cursorPage, bool) {
+	params := middleware.GetPaginationParams(c)
+	if params.Cursor == "" && params.Limit == "" {
+		return q, cursorPage{}, true
+	}
+
+	settings := params.Settings
+	validator := validation.New()
+	if settings.Cursors == nil {
+		validator.AddError("cursor", "cursor pagination is not enabled on this server", params.Cursor)
+	}
+	if params.Page != "" {
+		validator.AddError("page", "cannot be combined with cursor or limit", params.Page)
+	}
+	if params.PageSize != "" {
+		validator.AddError("pageSize", "cannot be combined with cursor or limit; use limit to size cursor pages", params.PageSize)
+	}
+	if q.Mode == domain.ListModePage {
+		validator.AddError("mode", "cursor pagination always lists the complete collection; omit mode or use collection", string(q.Mode))
+	}
+
+	at := pagination.Cursor{
+		Scope:   pagination.Digest(c.Request.URL.Path),
+		Filters: filtersDigest(q),
+		Sort:    sortDigest(q.Sort),
+	}
+
+	if params.Cursor != "" && settings.Cursors != nil {
+		if cursor, err := settings.Cursors.Decode(params.Cursor); err != nil {
+			validator.AddError("cursor", "is invalid or has been tampered with; use a nextCursor or prevCursor exactly as returned", params.Cursor)
+		} else if problem := cursorMismatch(cursor, at); problem != "" {
+			validator.AddError("cursor", problem, params.Cursor)
+		} else {
+			at.Offset, at.Limit = cursor.Offset, cursor.Limit
+		}
+	}
+
+	// An explicit limit resizes the pages from the cursor's position on
+	if params.Limit != "" && validator.ValidateIntRange("limit", params.Limit, settings.MinPageSize, settings.MaxPageSize) {
+		at.Limit, _ = strconv.Atoi(params.Limit) // Already validated above
+	}
+
+	if validator.HasErrors() {
+		response.ValidationError(c, validator.ErrorsMap())
+		return domain.ListQuery{}, cursorPage{}, false
+	}
+
+	q.Offset, q.Limit = at.Offset, at.Limit
+	return q, cursorPage{codec: settings.Cursors, at: at}, true
+}
+
+// cursorMismatch describes how the query a cursor was issued for differs from the
+// current one, or returns "" when they match.
+func cursorMismatch(cursor, current pagination.Cursor) string {
+	switch {
+	case cursor.Scope != current.Scope:
+		return "was issued for a different endpoint"
+	case cursor.Filters != current.Filters:
+		return "was issued for different filters; repeat the search, match, filter and range parameters of the request that returned it"
+	case cursor.Sort != current.Sort:
+		return "was issued for a different sort; repeat the sort, nulls and locale parameters of the request that returned it"
+	}
+	return ""
+}
+
+// linkCursors sets the cursors of the pages before and after a cursor-paginated result.
+// Results of page-numbered requests are left untouched.
+func linkCursors[T any](page cursorPage, result *domain.PaginatedResponse[T]) {
+	if page.at.Limit == 0 {
+		return
+	}
+
+	if end := page.at.Offset + len(result.Results); end < result.Count {
+		next := page.at
+		next.Offset = end
+		result.NextCursor = page.codec.Encode(next)
+	}
+
+	if page.at.Offset > 0 {
+		prev := page.at
+		prev.Offset = max(page.at.Offset-page.at.Limit, 0)
+		result.PrevCursor = page.codec.Encode(prev)
+	}
+}
+
+// rejectCursor rejects ?cursor= and ?limit= on list endpoints that only paginate by
+// page number, so they are not silently ignored. On failure the error response is
+// already sent.
+func rejectCursor(c *gin.Context) bool {
+	params := middleware.GetPaginationParams(c)
+
+	validator := validation.New()
+	if params.Cursor != "" {
+		validator.AddError("cursor", "cursor pagination is not supported on this endpoint; use page and pageSize", params.Cursor)
+	}
+	if params.Limit != "" {
+		validator.AddError("limit", "cursor pagination is not supported on this endpoint; use pageSize", params.Limit)
+	}
+
+	if validator.HasErrors() {
+		response.ValidationError(c, validator.ErrorsMap())
+		return false
+	}
+	return true
+}
+
+// filtersDigest fingerprints everything in q that decides which items are listed.
+// Omitted options are normalized to their defaults, so ?match=contains and no
+// ?match= share cursors, as do ?threshold=0.7 and no ?threshold=.
+func filtersDigest(q domain.ListQuery) string {
+	match := q.Match
+	if match == "" {
+		match = domain.MatchContains
+	}
+	threshold := q.Threshold
+	if threshold == 0 {
+		threshold = search.DefaultFuzzyThreshold
+	}
+
+	parts := []string{
+		q.Search,
+		string(match),
+		strconv.FormatFloat(threshold, 'g', -1, 64),
+		strings.Join(q.SearchIn, ","),
+		q.Filter,
+		strconv.FormatBool(q.Ranges.IncludeUnknown),
+	}
+	for _, r := range q.Ranges.Numbers {
+		parts = append(parts, r.Field, formatNumberBound(r.Min), formatNumberBound(r.Max))
+	}
+	for _, r := range q.Ranges.Dates {
+		parts = append(parts, r.Field, formatDateBound(r.From), formatDateBound(r.To))
+	}
+	return pagination.Digest(parts...)
+}
+
+// sortDigest fingerprints the sort keys, which decide the order items are listed in.
+// Omitted options are normalized to their defaults, so ?locale=en and no ?locale=
+// share cursors.
+func sortDigest(keys []domain.SortKey) string {
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		nulls := key.Nulls
+		if nulls == "" {
+			nulls = domain.NullsLast
+		}
+		locale := key.Locale
+		if locale == "" {
+			locale = sorting.DefaultLocale.String()
+		}
+		parts = append(parts, fmt.Sprintf("%s:%t:%s:%s", key.Field, key.Descending, nulls, locale))
+	}
+	return pagination.Digest(parts...)
+}
+
+// formatNumberBound formats an optional range bound; open bounds are empty.
+func formatNumberBound(bound *float64) string {
+	if bound == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*bound, 'g', -1, 64)
+}
+
+// formatDateBound formats an optional date bound; open bounds are empty.
+func formatDateBound(bound *time.Time) string {
+	if bound == nil {
+		return ""
+	}
+	return bound.Format(time.DateOnly)
+}
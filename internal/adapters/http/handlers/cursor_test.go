@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stressedbypull/swapi-connector/internal/pagination"
+	"github.com/stressedbypull/swapi-connector/internal/search"
+	"github.com/stressedbypull/swapi-connector/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// testPagination configures the pagination middleware of every handler test router.
+var testPagination = middleware.PaginationSettings{
+	DefaultPageSize: 15,
+	MinPageSize:     1,
+	MaxPageSize:     100,
+	Cursors:         pagination.NewCursorCodec([]byte("test-secret")),
+
+	TrustForwardedHeaders: true,
+}
+
+func TestPeopleHandler_ListPeople_Cursor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockRepo := mocks.NewMockSwapiRepository()
+	mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{
+		{Name: "Luke Skywalker", Mass: domain.Measure(77)},
+		{Name: "Darth Vader", Mass: domain.Measure(136)},
+		{Name: "Leia Organa", Mass: domain.Measure(49)},
+		{Name: "Jabba Desilijic Tiure", Mass: domain.Measure(1358)},
+		{Name: "Yoda", Mass: domain.Measure(17)},
+	}, nil)
+
+	service := services.NewPeopleService(mockRepo, services.NewCollectionCache(0, mockRepo.APIRetrieveAllPeople), nil, nil, services.ListSettings{PageSize: 15})
+	router := gin.New()
+	router.Use(middleware.PaginationMiddleware(testPagination))
+	router.Use(middleware.QueryMiddleware())
+	router.GET("/people", NewPeopleHandler(service).ListPeople)
+	router.GET("/planets", NewPlanetHandler(services.NewPlanetService(mockRepo, services.NewCollectionCache(0, mockRepo.FetchAllPlanets), nil, nil, services.ListSettings{})).ListPlanets)
+
+	get := func(t *testing.T, target string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, target, nil)
+		req.Host = "swapi.local"
+		router.ServeHTTP(w, req)
+		return w
+	}
+	list := func(t *testing.T, target string) domain.PaginatedResponse[domain.Person] {
+		w := get(t, target)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+		var resp domain.PaginatedResponse[domain.Person]
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+	names := func(people []domain.Person) []string {
+		var out []string
+		for _, p := range people {
+			out = append(out, p.Name)
+		}
+		return out
+	}
+
+	first := list(t, "/people?sortBy=mass&limit=2")
+	assert.Equal(t, 5, first.Count)
+	assert.Zero(t, first.Page)
+	assert.Equal(t, []string{"Yoda", "Leia Organa"}, names(first.Results))
+	assert.Empty(t, first.PrevCursor)
+	require.NotEmpty(t, first.NextCursor)
+	assert.Equal(t, 3, first.TotalPages)
+	assert.True(t, first.HasNext)
+	assert.False(t, first.HasPrevious)
+	assert.Equal(t, "http://swapi.local/people?limit=2&sortBy=mass", first.First)
+	assert.Equal(t, "http://swapi.local/people?cursor="+url.QueryEscape(first.NextCursor)+"&limit=2&sortBy=mass", first.Next)
+
+	lastPage := list(t, first.Last)
+	assert.Equal(t, []string{"Jabba Desilijic Tiure"}, names(lastPage.Results))
+	assert.False(t, lastPage.HasNext)
+
+	second := list(t, "/people?sortBy=mass&cursor="+url.QueryEscape(first.NextCursor))
+	assert.Equal(t, []string{"Luke Skywalker", "Darth Vader"}, names(second.Results))
+	require.NotEmpty(t, second.PrevCursor)
+	require.NotEmpty(t, second.NextCursor)
+	assert.Equal(t, first.First, second.Previous)
+
+	last := list(t, "/people?sortBy=mass&cursor="+url.QueryEscape(second.NextCursor))
+	assert.Equal(t, []string{"Jabba Desilijic Tiure"}, names(last.Results))
+	assert.Empty(t, last.NextCursor)
+
+	back := list(t, "/people?sortBy=mass&cursor="+url.QueryEscape(second.PrevCursor))
+	assert.Equal(t, names(first.Results), names(back.Results))
+
+	resized := list(t, "/people?sortBy=mass&limit=3&cursor="+url.QueryEscape(first.NextCursor))
+	assert.Equal(t, []string{"Luke Skywalker", "Darth Vader", "Jabba Desilijic Tiure"}, names(resized.Results))
+
+	// Spelling out the default locale keeps the same order, so the cursor still applies
+	explicitLocale := list(t, "/people?sortBy=mass&locale=en&cursor="+url.QueryEscape(first.NextCursor))
+	assert.Equal(t, names(second.Results), names(explicitLocale.Results))
+	regionalLocale := list(t, "/people?sortBy=mass&locale=en-GB&cursor="+url.QueryEscape(first.NextCursor))
+	assert.Equal(t, names(second.Results), names(regionalLocale.Results))
+
+	tampered := []byte(first.NextCursor)
+	tampered[0] ^= 1
+
+	invalid := []struct {
+		name         string
+		url          string
+		wantField    string
+		wantContains string
+	}{
+		{"different filters", "/people?sortBy=mass&search=sky&cursor=" + url.QueryEscape(first.NextCursor), "cursor", "different filters"},
+		{"different sort", "/people?sortBy=name&cursor=" + url.QueryEscape(first.NextCursor), "cursor", "different sort"},
+		{"different endpoint", "/planets?cursor=" + url.QueryEscape(first.NextCursor), "cursor", "different endpoint"},
+		{"tampered cursor", "/people?sortBy=mass&cursor=" + url.QueryEscape(string(tampered)), "cursor", "tampered"},
+		{"not a cursor", "/people?cursor=page-2", "cursor", "invalid"},
+		{"cursor with pageSize", "/people?limit=2&pageSize=2", "pageSize", "use limit"},
+		{"cursor with page", "/people?sortBy=mass&page=2&cursor=" + url.QueryEscape(first.NextCursor), "page", "cannot be combined"},
+		{"cursor in page mode", "/people?limit=2&mode=page", "mode", "complete collection"},
+		{"zero limit", "/people?limit=0", "limit", "between 1 and 100"},
+		{"limit above maximum", "/people?limit=101", "limit", "between 1 and 100"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			w := get(t, tt.url)
+			require.Equal(t, http.StatusBadRequest, w.Code)
+
+			var resp response.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+			message, _ := resp.Error.Details[tt.wantField].(string)
+			assert.Contains(t, message, tt.wantContains)
+		})
+	}
+}
+
+func TestFiltersDigest_NormalizesDefaults(t *testing.T) {
+	implicit := domain.ListQuery{Search: "sky"}
+	explicit := domain.ListQuery{Search: "sky", Match: domain.MatchContains, Threshold: search.DefaultFuzzyThreshold}
+	assert.Equal(t, filtersDigest(implicit), filtersDigest(explicit))
+
+	explicit.Threshold = 0.5
+	assert.NotEqual(t, filtersDigest(implicit), filtersDigest(explicit))
+}
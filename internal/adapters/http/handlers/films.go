@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
+	"github.com/stressedbypull/swapi-connector/internal/ports"
+)
+
+// FilmHandler handles HTTP requests for film resources.
+type FilmHandler struct {
+	service ports.FilmServiceInterface
+}
+
+// NewFilmHandler creates a new film handler with dependency injection.
+func NewFilmHandler(service ports.FilmServiceInterface) *FilmHandler {
+	return &FilmHandler{
+		service: service,
+	}
+}
+
+// ListFilms godoc
+// @Summary      List Star Wars films
+// @Description  Get a paginated list of films from SWAPI with optional search and sorting
+// @Tags         films
+// @Accept       json
+// @Produce      json
+// @Param        page       query     int     false  "Page number"           default(1)       example(1)
+// @Param        pageSize   query     int     false  "Items per page (1-100 by default, configurable)"  default(15)  example(25)
+// @Param        search     query     string  false  "Search by title"       example(hope)
+// @Param        sort       query     string  false  "Multi-key sort (field:asc|desc, comma-separated); replaces sortBy/sortOrder"  example(releaseDate:desc,title)
+// @Param        sortBy     query     string  false  "Sort field"  example(episode)
+// @Param        sortOrder  query     string  false  "Sort order"            Enums(asc, desc)            default(asc)  example(asc)
+// @Param        locale     query     string  false  "Collation locale for name sorting (BCP 47)"  default(en)  example(sv)
+// @Success      200  {object}  FilmListResponse    "Successful response with film list"
+// @Failure      400  {object}  ErrorResponse       "Invalid request parameters"
+// @Failure      404  {object}  ErrorResponse       "Film not found"
+// @Failure      500  {object}  ErrorResponse       "Internal server error"
+// @Router       /films [get]
+func (h *FilmHandler) ListFilms(c *gin.Context) {
+	// Parse and validate query parameters
+	params, ok := ParseFilmQueryParams(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	// Call service
+	result, err := h.service.ListFilms(
+		c.Request.Context(),
+		params.Page,
+		params.PageSize,
+		params.Search,
+		params.Sort,
+	)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	linkPages(c, &result, params.PageSize, cursorPage{})
+	response.OK(c, result)
+}
+
+// GetFilmByID godoc
+// @Summary      Get a Star Wars film
+// @Description  Get a single film from SWAPI by its numeric ID
+// @Tags         films
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Film ID"  example(1)
+// @Success      200  {object}  Film           "Successful response with film"
+// @Failure      400  {object}  ErrorResponse  "Invalid film ID"
+// @Failure      404  {object}  ErrorResponse  "Film not found"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Router       /films/{id} [get]
+func (h *FilmHandler) GetFilmByID(c *gin.Context) {
+	// Validate ID before calling upstream
+	id, ok := ParseResourceID(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	film, err := h.service.GetFilmByID(c.Request.Context(), id)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, film)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
 	"github.com/stressedbypull/swapi-connector/internal/adapters/swapi"
 	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/pagination"
 	"github.com/stressedbypull/swapi-connector/internal/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,8 +28,8 @@ func TestPeopleHandler_Integration(t *testing.T) {
 
 	// Setup REAL dependencies - connecting to actual SWAPI
 	httpClient := &http.Client{Timeout: 10 * time.Second}
-	swapiClient := swapi.NewClient("https://swapi.dev/api", httpClient)
-	peopleService := services.NewPeopleService(swapiClient)
+	swapiClient := swapi.NewClient("https://swapi.dev/api", httpClient, 4)
+	peopleService := services.NewPeopleService(swapiClient, services.NewCollectionCache(0, swapiClient.APIRetrieveAllPeople), nil, nil, services.ListSettings{PageSize: 15})
 	handler := handlers.NewPeopleHandler(peopleService)
 
 	tests := []struct {
@@ -54,7 +55,8 @@ func TestPeopleHandler_Integration(t *testing.T) {
 				// Check that we got real data
 				if len(resp.Results) > 0 {
 					assert.NotEmpty(t, resp.Results[0].Name)
-					assert.Greater(t, resp.Results[0].Mass, 0)
+					assert.True(t, resp.Results[0].Mass.Known)
+					assert.Greater(t, resp.Results[0].Mass.Value, 0.0)
 				}
 			},
 		},
@@ -110,7 +112,9 @@ func TestPeopleHandler_Integration(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			// Create router with middleware for each test
 			router := gin.New()
-			router.Use(middleware.PaginationMiddleware())
+			router.Use(middleware.PaginationMiddleware(middleware.PaginationSettings{
+				DefaultPageSize: 15, MinPageSize: 1, MaxPageSize: 100, Cursors: pagination.NewCursorCodec(nil),
+			}))
 			router.Use(middleware.QueryMiddleware())
 			router.GET("/people", handler.ListPeople)
 
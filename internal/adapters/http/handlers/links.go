@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+// linkPages fills in the navigation metadata of a list response: totalPages,
+// hasNext/hasPrevious and absolute first/previous/next/last URLs that keep the
+// request's other query parameters. The same URLs are sent as an RFC 8288 Link header.
+// pageSize is the requested page size; cursor-paginated results use the cursor's limit.
+func linkPages[T any](c *gin.Context, result *domain.PaginatedResponse[T], pageSize int, cursor cursorPage) {
+	if cursor.at.Limit > 0 {
+		linkCursorPages(c, result, cursor)
+	} else {
+		linkNumberedPages(c, result, pageSize)
+	}
+
+	setLinkHeader(c, result)
+}
+
+// linkNumberedPages fills in the navigation of a page-numbered result.
+func linkNumberedPages[T any](c *gin.Context, result *domain.PaginatedResponse[T], pageSize int) {
+	if pageSize <= 0 {
+		return
+	}
+
+	result.TotalPages = totalPages(result.Count, pageSize)
+	result.HasPrevious = result.Page > 1
+	result.HasNext = result.Page < result.TotalPages
+
+	pageURL := func(page int) string {
+		return requestURL(c, func(query url.Values) { query.Set("page", strconv.Itoa(page)) })
+	}
+
+	result.First = pageURL(1)
+	result.Last = pageURL(max(result.TotalPages, 1))
+	if result.HasPrevious {
+		result.Previous = pageURL(min(result.Page-1, max(result.TotalPages, 1)))
+	}
+	if result.HasNext {
+		result.Next = pageURL(result.Page + 1)
+	}
+}
+
+// linkCursorPages fills in the cursors and navigation of a cursor-paginated result.
+// The first page is addressed by its limit alone, every other page by a cursor.
+func linkCursorPages[T any](c *gin.Context, result *domain.PaginatedResponse[T], cursor cursorPage) {
+	linkCursors(cursor, result)
+
+	limit := cursor.at.Limit
+	result.TotalPages = totalPages(result.Count, limit)
+	result.HasPrevious = result.PrevCursor != ""
+	result.HasNext = result.NextCursor != ""
+
+	cursorURL := func(token string) string {
+		return requestURL(c, func(query url.Values) {
+			query.Set("limit", strconv.Itoa(limit))
+			if token == "" {
+				query.Del("cursor")
+			} else {
+				query.Set("cursor", token)
+			}
+		})
+	}
+
+	result.First = cursorURL("")
+	if result.TotalPages > 1 {
+		last := cursor.at
+		last.Offset = (result.TotalPages - 1) * limit
+		result.Last = cursorURL(cursor.codec.Encode(last))
+	} else {
+		result.Last = result.First
+	}
+	if result.HasPrevious {
+		if cursor.at.Offset <= limit {
+			result.Previous = result.First
+		} else {
+			result.Previous = cursorURL(result.PrevCursor)
+		}
+	}
+	if result.HasNext {
+		result.Next = cursorURL(result.NextCursor)
+	}
+}
+
+// totalPages returns the number of pages of pageSize items needed for count items.
+func totalPages(count, pageSize int) int {
+	return (count + pageSize - 1) / pageSize
+}
+
+// requestURL returns the absolute URL of the current request with its query
+// parameters changed by edit. Scheme and host come from X-Forwarded-Proto and
+// X-Forwarded-Host when forwarded headers are trusted, so links point at the
+// ingress rather than at this server. A forwarded host that is not a bare
+// host[:port] is ignored.
+func requestURL(c *gin.Context, edit func(query url.Values)) string {
+	query := c.Request.URL.Query()
+	edit(query)
+
+	scheme, host := "http", c.Request.Host
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	if middleware.GetPaginationParams(c).Settings.TrustForwardedHeaders {
+		if proto := firstHeaderValue(c, "X-Forwarded-Proto"); proto == "http" || proto == "https" {
+			scheme = proto
+		}
+		if forwardedHost := firstHeaderValue(c, "X-Forwarded-Host"); isBareHost(forwardedHost) {
+			host = forwardedHost
+		}
+	}
+
+	u := url.URL{Scheme: scheme, Host: host, Path: c.Request.URL.Path, RawQuery: query.Encode()}
+	return u.String()
+}
+
+// isBareHost reports whether host is a plain host[:port] such as "api.example.com"
+// or "[::1]:8443", without user info, path, query or fragment.
+func isBareHost(host string) bool {
+	if host == "" {
+		return false
+	}
+	u, err := url.Parse("http://" + host)
+	return err == nil && u.Host == host && u.User == nil && u.Path == "" &&
+		u.RawQuery == "" && u.Fragment == "" && u.Hostname() != ""
+}
+
+// firstHeaderValue returns the first entry of a comma-separated header that
+// proxies may have appended to, e.g. "https, http" -> "https".
+func firstHeaderValue(c *gin.Context, name string) string {
+	value, _, _ := strings.Cut(c.GetHeader(name), ",")
+	return strings.TrimSpace(value)
+}
+
+// setLinkHeader sends the result's navigation URLs as an RFC 8288 Link header.
+func setLinkHeader[T any](c *gin.Context, result *domain.PaginatedResponse[T]) {
+	var links []string
+	for _, link := range []struct{ rel, target string }{
+		{"first", result.First},
+		{"prev", result.Previous},
+		{"next", result.Next},
+		{"last", result.Last},
+	} {
+		if link.target != "" {
+			links = append(links, fmt.Sprintf("<%s>; rel=%q", link.target, link.rel))
+		}
+	}
+
+	if len(links) > 0 {
+		c.Header("Link", strings.Join(links, ", "))
+	}
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stressedbypull/swapi-connector/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkPages(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	untrusted := testPagination
+	untrusted.TrustForwardedHeaders = false
+
+	tests := []struct {
+		name            string
+		url             string
+		headers         map[string]string
+		settings        middleware.PaginationSettings
+		page            int
+		wantTotalPages  int
+		wantHasNext     bool
+		wantHasPrevious bool
+		wantFirst       string
+		wantPrevious    string
+		wantNext        string
+		wantLast        string
+	}{
+		{
+			name:            "middle page keeps the other query parameters",
+			url:             "/people?page=2&pageSize=10&search=sky",
+			settings:        testPagination,
+			page:            2,
+			wantTotalPages:  3,
+			wantHasNext:     true,
+			wantHasPrevious: true,
+			wantFirst:       "http://swapi.local/people?page=1&pageSize=10&search=sky",
+			wantPrevious:    "http://swapi.local/people?page=1&pageSize=10&search=sky",
+			wantNext:        "http://swapi.local/people?page=3&pageSize=10&search=sky",
+			wantLast:        "http://swapi.local/people?page=3&pageSize=10&search=sky",
+		},
+		{
+			name:            "last page has no next link",
+			url:             "/people?page=3&pageSize=10",
+			settings:        testPagination,
+			page:            3,
+			wantTotalPages:  3,
+			wantHasPrevious: true,
+			wantFirst:       "http://swapi.local/people?page=1&pageSize=10",
+			wantPrevious:    "http://swapi.local/people?page=2&pageSize=10",
+			wantLast:        "http://swapi.local/people?page=3&pageSize=10",
+		},
+		{
+			name:           "forwarded scheme and host are honored",
+			url:            "/people?pageSize=10",
+			headers:        map[string]string{"X-Forwarded-Proto": "https, http", "X-Forwarded-Host": "api.example.com"},
+			settings:       testPagination,
+			page:           1,
+			wantTotalPages: 3,
+			wantHasNext:    true,
+			wantFirst:      "https://api.example.com/people?page=1&pageSize=10",
+			wantNext:       "https://api.example.com/people?page=2&pageSize=10",
+			wantLast:       "https://api.example.com/people?page=3&pageSize=10",
+		},
+		{
+			name:           "forwarded host with a port is honored",
+			url:            "/people?pageSize=10",
+			headers:        map[string]string{"X-Forwarded-Host": "api.example.com:8443"},
+			settings:       testPagination,
+			page:           1,
+			wantTotalPages: 3,
+			wantHasNext:    true,
+			wantFirst:      "http://api.example.com:8443/people?page=1&pageSize=10",
+			wantNext:       "http://api.example.com:8443/people?page=2&pageSize=10",
+			wantLast:       "http://api.example.com:8443/people?page=3&pageSize=10",
+		},
+		{
+			name:           "forwarded host that is not a bare host is ignored",
+			url:            "/people?pageSize=10",
+			headers:        map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "evil.example.com/phish?x="},
+			settings:       testPagination,
+			page:           1,
+			wantTotalPages: 3,
+			wantHasNext:    true,
+			wantFirst:      "https://swapi.local/people?page=1&pageSize=10",
+			wantNext:       "https://swapi.local/people?page=2&pageSize=10",
+			wantLast:       "https://swapi.local/people?page=3&pageSize=10",
+		},
+		{
+			name:           "forwarded headers are ignored unless trusted",
+			url:            "/people?pageSize=10",
+			headers:        map[string]string{"X-Forwarded-Proto": "https", "X-Forwarded-Host": "evil.example.com"},
+			settings:       untrusted,
+			page:           1,
+			wantTotalPages: 3,
+			wantHasNext:    true,
+			wantFirst:      "http://swapi.local/people?page=1&pageSize=10",
+			wantNext:       "http://swapi.local/people?page=2&pageSize=10",
+			wantLast:       "http://swapi.local/people?page=3&pageSize=10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			mockRepo.On("APIRetrievePeople", mock.Anything, tt.page, 10, mock.Anything).
+				Return(domain.PaginatedResponse[domain.Person]{Count: 25, Page: tt.page, Results: []domain.Person{{Name: "Luke Skywalker"}}}, nil)
+
+			router := gin.New()
+			router.Use(middleware.PaginationMiddleware(tt.settings))
+			router.Use(middleware.QueryMiddleware())
+			router.GET("/people", NewPeopleHandler(services.NewPeopleService(mockRepo, services.NewCollectionCache(0, mockRepo.APIRetrieveAllPeople), nil, nil, services.ListSettings{})).ListPeople)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			req.Host = "swapi.local"
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+			router.ServeHTTP(w, req)
+			require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+
+			var resp domain.PaginatedResponse[domain.Person]
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+			assert.Equal(t, tt.wantTotalPages, resp.TotalPages)
+			assert.Equal(t, tt.wantHasNext, resp.HasNext)
+			assert.Equal(t, tt.wantHasPrevious, resp.HasPrevious)
+			assert.Equal(t, tt.wantFirst, resp.First)
+			assert.Equal(t, tt.wantPrevious, resp.Previous)
+			assert.Equal(t, tt.wantNext, resp.Next)
+			assert.Equal(t, tt.wantLast, resp.Last)
+
+			link := w.Header().Get("Link")
+			assert.Contains(t, link, "<"+tt.wantFirst+`>; rel="first"`)
+			assert.Contains(t, link, "<"+tt.wantLast+`>; rel="last"`)
+			if tt.wantNext != "" {
+				assert.Contains(t, link, "<"+tt.wantNext+`>; rel="next"`)
+			} else {
+				assert.NotContains(t, link, `rel="next"`)
+			}
+			if tt.wantPrevious != "" {
+				assert.Contains(t, link, "<"+tt.wantPrevious+`>; rel="prev"`)
+			}
+		})
+	}
+}
+
+func TestIsBareHost(t *testing.T) {
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{"api.example.com", true},
+		{"api.example.com:8443", true},
+		{"10.0.0.1:80", true},
+		{"[::1]:8443", true},
+		{"", false},
+		{"evil.example.com/path", false},
+		{"user@evil.example.com", false},
+		{"evil.example.com?x=1", false},
+		{"evil.example.com#top", false},
+		{"evil.example.com:port", false},
+		{"evil example.com", false},
+		{":8443", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			assert.Equal(t, tt.want, isBareHost(tt.host))
+		})
+	}
+}
@@ -25,9 +25,30 @@ func NewPeopleHandler(service ports.PeopleServiceInterface) *PeopleHandler {
 // @Accept       json
 // @Produce      json
 // @Param        page       query     int     false  "Page number"           default(1)       example(1)
+// @Param        pageSize   query     int     false  "Items per page (1-100 by default, configurable)"  default(15)  example(25)
+// @Param        limit      query     int     false  "Items per page for cursor pagination (1-100); replaces page"  example(10)
+// @Param        cursor     query     string  false  "nextCursor or prevCursor of a previous response, with the same search, filter and sort parameters"
 // @Param        search     query     string  false  "Search by name"        example(luke)
-// @Param        sortBy     query     string  false  "Sort field"            Enums(name, created, mass)  example(name)
+// @Param        match      query     string  false  "Search matching: contains, or fuzzy with relevance scores"  Enums(contains, fuzzy)  default(contains)
+// @Param        threshold  query     number  false  "Minimum fuzzy relevance in (0, 1]"  default(0.7)  example(0.7)
+// @Param        searchIn   query     string  false  "Fields to search (comma-separated)"  Enums(name, homeworld, films, species)  example(homeworld)
+// @Param        filter     query     string  false  "Filter expression, e.g. mass>80 and gender=male"  example(mass>80)
+// @Param        massMin         query     number  false  "Minimum mass in kg (inclusive)"  example(50)
+// @Param        massMax         query     number  false  "Maximum mass in kg (inclusive)"  example(100)
+// @Param        heightMin       query     number  false  "Minimum height in cm (inclusive)"  example(150)
+// @Param        heightMax       query     number  false  "Maximum height in cm (inclusive)"  example(200)
+// @Param        createdFrom     query     string  false  "Created on or after (YYYY-MM-DD)"  example(2014-12-09)
+// @Param        createdTo       query     string  false  "Created on or before (YYYY-MM-DD)"  example(2014-12-20)
+// @Param        editedFrom      query     string  false  "Edited on or after (YYYY-MM-DD)"  example(2014-12-09)
+// @Param        editedTo        query     string  false  "Edited on or before (YYYY-MM-DD)"  example(2014-12-20)
+// @Param        includeUnknown  query     bool    false  "Keep records whose ranged value is unknown"  default(false)
+// @Param        sort       query     string  false  "Multi-key sort (field:asc|desc, comma-separated); replaces sortBy/sortOrder"  example(mass:desc,name)
+// @Param        sortBy     query     string  false  "Sort field"  example(name)
 // @Param        sortOrder  query     string  false  "Sort order"            Enums(asc, desc)            default(asc)  example(asc)
+// @Param        locale     query     string  false  "Collation locale for name sorting (BCP 47)"  default(en)  example(sv)
+// @Param        nulls      query     string  false  "Where unknown numeric values sort, whatever the direction"  Enums(first, last)  default(last)
+// @Param        expand     query     string  false  "Relations to embed (comma-separated)"  Enums(films, homeworld)  example(films)
+// @Param        mode       query     string  false  "List mode: page filters/sorts one upstream page, collection the whole collection"  Enums(page, collection)  example(collection)
 // @Success      200  {object}  PeopleListResponse  "Successful response with people list"
 // @Failure      400  {object}  ErrorResponse       "Invalid request parameters"
 // @Failure      404  {object}  ErrorResponse       "Person not found"
@@ -40,18 +61,110 @@ func (h *PeopleHandler) ListPeople(c *gin.Context) {
 		return // Validation error already sent
 	}
 
+	q, cursor, ok := parseCursor(c, params.ListQuery())
+	if !ok {
+		return // Validation error already sent
+	}
+
 	// Call service
-	result, err := h.service.ListPeople(
-		c.Request.Context(),
-		params.Page,
-		params.Search,
-		params.SortBy,
-		params.SortOrder,
-	)
+	result, err := h.service.ListPeople(c.Request.Context(), q)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	linkPages(c, &result, params.PageSize, cursor)
+	response.OK(c, result)
+}
+
+// GetPersonByID godoc
+// @Summary      Get a Star Wars character
+// @Description  Get a single person from SWAPI by their numeric ID
+// @Tags         people
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Person ID"  example(1)
+// @Param        expand  query     string  false  "Relations to embed (comma-separated)"  Enums(films, homeworld)  example(films)
+// @Success      200  {object}  Person         "Successful response with person"
+// @Failure      400  {object}  ErrorResponse  "Invalid person ID"
+// @Failure      404  {object}  ErrorResponse  "Person not found"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Router       /people/{id} [get]
+func (h *PeopleHandler) GetPersonByID(c *gin.Context) {
+	// Validate ID before calling upstream
+	id, ok := ParseResourceID(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	expand, ok := ParsePeopleExpand(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	person, err := h.service.GetPeopleByID(c.Request.Context(), id, expand)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, person)
+}
+
+// ListPersonFilms godoc
+// @Summary      List the films a character appears in
+// @Description  Resolve a person's film URLs into full films, with optional search, sorting and pagination
+// @Tags         people
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int     true   "Person ID"             example(1)
+// @Param        page       query     int     false  "Page number"           default(1)       example(1)
+// @Param        pageSize   query     int     false  "Items per page (1-100 by default, configurable)"  default(15)  example(25)
+// @Param        limit      query     int     false  "Items per page for cursor pagination (1-100); replaces page"  example(10)
+// @Param        cursor     query     string  false  "nextCursor or prevCursor of a previous response, with the same search, filter and sort parameters"
+// @Param        search     query     string  false  "Search by title"       example(hope)
+// @Param        filter     query     string  false  "Filter expression, e.g. episodeId<=3"  example(episodeId<=3)
+// @Param        sort       query     string  false  "Multi-key sort (field:asc|desc, comma-separated); replaces sortBy/sortOrder"  example(releaseDate:desc,title)
+// @Param        sortBy     query     string  false  "Sort field"  example(episode)
+// @Param        sortOrder  query     string  false  "Sort order"            Enums(asc, desc)            default(asc)  example(asc)
+// @Param        locale     query     string  false  "Collation locale for name sorting (BCP 47)"  default(en)  example(sv)
+// @Success      200  {object}  FilmListResponse  "Successful response with the person's films"
+// @Failure      400  {object}  ErrorResponse     "Invalid request parameters"
+// @Failure      404  {object}  ErrorResponse     "Person not found"
+// @Failure      500  {object}  ErrorResponse     "Internal server error"
+// @Router       /people/{id}/films [get]
+func (h *PeopleHandler) ListPersonFilms(c *gin.Context) {
+	// Validate ID before calling upstream
+	id, ok := ParseResourceID(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	// Nested films sort like the top-level films endpoint
+	params, ok := parseListQueryParams(c, allowedFilmSortBy)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	filter, ok := ParseFilmFilter(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	q := params.ListQuery()
+	q.Filter = filter
+
+	q, cursor, ok := parseCursor(c, q)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	result, err := h.service.ListPersonFilms(c.Request.Context(), id, q)
 	if err != nil {
 		response.HandleError(c, err)
 		return
 	}
 
+	linkPages(c, &result, params.PageSize, cursor)
 	response.OK(c, result)
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
 	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
 	"github.com/stressedbypull/swapi-connector/internal/domain"
+	errDomain "github.com/stressedbypull/swapi-connector/internal/errors"
 	"github.com/stressedbypull/swapi-connector/internal/mocks"
 	"github.com/stressedbypull/swapi-connector/internal/services"
 	"github.com/stretchr/testify/assert"
@@ -36,11 +37,11 @@ func TestPeopleHandler_ListPeople(t *testing.T) {
 					Count: 2,
 					Page:  1,
 					Results: []domain.Person{
-						{Name: "Luke Skywalker", Mass: 77, Films: []string{"film1"}},
-						{Name: "Darth Vader", Mass: 136, Films: []string{"film2"}},
+						{Name: "Luke Skywalker", Mass: domain.Measure(77), Films: []string{"film1"}},
+						{Name: "Darth Vader", Mass: domain.Measure(136), Films: []string{"film2"}},
 					},
 				}
-				m.On("APIRetrievePeople", mock.Anything, 1, "").Return(mockResp, nil)
+				m.On("APIRetrievePeople", mock.Anything, 1, 15, "").Return(mockResp, nil)
 			},
 			expectedStatus: http.StatusOK,
 			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
@@ -62,11 +63,11 @@ func TestPeopleHandler_ListPeople(t *testing.T) {
 					Count: 2,
 					Page:  1,
 					Results: []domain.Person{
-						{Name: "Leia Organa", Mass: 49, Films: []string{"film1"}},
-						{Name: "Darth Vader", Mass: 136, Films: []string{"film2"}},
+						{Name: "Leia Organa", Mass: domain.Measure(49), Films: []string{"film1"}},
+						{Name: "Darth Vader", Mass: domain.Measure(136), Films: []string{"film2"}},
 					},
 				}
-				m.On("APIRetrievePeople", mock.Anything, 1, "").Return(mockResp, nil)
+				m.On("APIRetrievePeople", mock.Anything, 1, 15, "").Return(mockResp, nil)
 			},
 			expectedStatus: http.StatusOK,
 			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
@@ -96,6 +97,364 @@ func TestPeopleHandler_ListPeople(t *testing.T) {
 				assert.Contains(t, resp.Error.Message, "Validation failed")
 			},
 		},
+		{
+			name: "invalid page is rejected instead of falling back to page 1",
+			url:  "/people?page=abc",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+				assert.Equal(t, "must be a positive integer", resp.Error.Details["page"])
+			},
+		},
+		{
+			name: "pageSize is passed to the repository",
+			url:  "/people?page=2&pageSize=25",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				mockResp := domain.PaginatedResponse[domain.Person]{Count: 82, Page: 2, PageSize: 25}
+				m.On("APIRetrievePeople", mock.Anything, 2, 25, "").Return(mockResp, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "pageSize outside the configured bounds",
+			url:  "/people?pageSize=101",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Equal(t, "must be an integer between 1 and 100", resp.Error.Details["pageSize"])
+			},
+		},
+		{
+			name: "multi-key sort",
+			url:  "/people?sort=mass:desc,name:asc",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				mockResp := domain.PaginatedResponse[domain.Person]{
+					Count: 3,
+					Page:  1,
+					Results: []domain.Person{
+						{Name: "Luke Skywalker", Mass: domain.Measure(77)},
+						{Name: "Darth Vader", Mass: domain.Measure(136)},
+						{Name: "Biggs Darklighter", Mass: domain.Measure(77)},
+					},
+				}
+				m.On("APIRetrievePeople", mock.Anything, 1, 15, "").Return(mockResp, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp domain.PaginatedResponse[domain.Person]
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				require.Len(t, resp.Results, 3)
+				assert.Equal(t, "Darth Vader", resp.Results[0].Name)
+				assert.Equal(t, "Biggs Darklighter", resp.Results[1].Name)
+				assert.Equal(t, "Luke Skywalker", resp.Results[2].Name)
+			},
+		},
+		{
+			name: "multi-key sort names each unsupported key",
+			url:  "/people?sort=height:desc,name,eyeColor:asc,mass:down",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+				message, _ := resp.Error.Details["sort"].(string)
+				assert.Contains(t, message, "unsupported sort keys: height, eyeColor")
+				assert.Contains(t, message, "invalid directions in: mass:down")
+			},
+		},
+		{
+			name: "nulls=first places unknown mass first and serializes it as null",
+			url:  "/people?sortBy=mass&sortOrder=desc&nulls=first",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				mockResp := domain.PaginatedResponse[domain.Person]{
+					Count: 3,
+					Page:  1,
+					Results: []domain.Person{
+						{Name: "Luke Skywalker", Mass: domain.Measure(77)},
+						{Name: "Arvel Crynyd"},
+						{Name: "Owen Lars", Mass: domain.Measure(120)},
+					},
+				}
+				m.On("APIRetrievePeople", mock.Anything, 1, 15, "").Return(mockResp, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp struct {
+					Results []struct {
+						Name string   `json:"name"`
+						Mass *float64 `json:"mass"`
+					} `json:"results"`
+				}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				require.Len(t, resp.Results, 3)
+				assert.Equal(t, "Arvel Crynyd", resp.Results[0].Name)
+				assert.Nil(t, resp.Results[0].Mass, "unknown mass should be null")
+				assert.Equal(t, "Owen Lars", resp.Results[1].Name)
+				assert.Equal(t, "Luke Skywalker", resp.Results[2].Name)
+			},
+		},
+		{
+			name: "locale collates names",
+			url:  "/people?sortBy=name&locale=sv",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				mockResp := domain.PaginatedResponse[domain.Person]{
+					Count: 3,
+					Page:  1,
+					Results: []domain.Person{
+						{Name: "Ödön"},
+						{Name: "Zam Wesell"},
+						{Name: "Obi-Wan Kenobi"},
+					},
+				}
+				m.On("APIRetrievePeople", mock.Anything, 1, 15, "").Return(mockResp, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp domain.PaginatedResponse[domain.Person]
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				require.Len(t, resp.Results, 3)
+				// Swedish sorts Ö after Z
+				assert.Equal(t, "Obi-Wan Kenobi", resp.Results[0].Name)
+				assert.Equal(t, "Zam Wesell", resp.Results[1].Name)
+				assert.Equal(t, "Ödön", resp.Results[2].Name)
+			},
+		},
+		{
+			name: "invalid locale",
+			url:  "/people?sortBy=name&locale=not_a_locale",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Contains(t, resp.Error.Details, "locale")
+			},
+		},
+		{
+			name: "invalid nulls placement",
+			url:  "/people?sort=mass&nulls=middle",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Contains(t, resp.Error.Details, "nulls")
+			},
+		},
+		{
+			name: "sort cannot be combined with sortBy",
+			url:  "/people?sort=mass&sortBy=name",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Contains(t, resp.Error.Details, "sort")
+			},
+		},
+		{
+			name: "residents is not a valid people expand relation",
+			url:  "/people?expand=films,residents",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				err := json.Unmarshal(w.Body.Bytes(), &resp)
+				require.NoError(t, err)
+
+				assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+				assert.Contains(t, resp.Error.Details, "expand")
+			},
+		},
+		{
+			name: "collection mode sorts the whole collection",
+			url:  "/people?mode=collection&sortBy=mass&sortOrder=desc",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{
+					{Name: "Luke Skywalker", Mass: domain.Measure(77)},
+					{Name: "Jabba Desilijic Tiure", Mass: domain.Measure(1358)},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp domain.PaginatedResponse[domain.Person]
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Equal(t, 2, resp.Count)
+				assert.Equal(t, "Jabba Desilijic Tiure", resp.Results[0].Name)
+			},
+		},
+		{
+			name: "filter expression applied to the whole collection",
+			url:  "/people?filter=mass>80%20and%20gender=male",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{
+					{Name: "Luke Skywalker", Mass: domain.Measure(77), Gender: "male"},
+					{Name: "Darth Vader", Mass: domain.Measure(136), Gender: "male"},
+					{Name: "Leia Organa", Mass: domain.Measure(49), Gender: "female"},
+					{Name: "Jabba Desilijic Tiure", Mass: domain.Measure(1358), Gender: "hermaphrodite"},
+					{Name: "Owen Lars", Mass: domain.Measure(120), Gender: "male"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp domain.PaginatedResponse[domain.Person]
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				// count describes the filtered collection, not the upstream total
+				assert.Equal(t, 2, resp.Count)
+				require.Len(t, resp.Results, 2)
+				assert.Equal(t, "Darth Vader", resp.Results[0].Name)
+				assert.Equal(t, "Owen Lars", resp.Results[1].Name)
+			},
+		},
+		{
+			name: "invalid filter reports the token position",
+			url:  "/people?filter=mass>heavy",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+				assert.Contains(t, resp.Error.Details, "filter")
+				assert.Contains(t, w.Body.String(), "at position 6")
+			},
+		},
+		{
+			name: "massMax drops unknown mass unless includeUnknown",
+			url:  "/people?massMax=80",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// Ranges are applied to the complete collection, not one upstream page
+				m.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{
+					{Name: "Luke Skywalker", Mass: domain.Measure(77)},
+					{Name: "Darth Vader", Mass: domain.Measure(136)},
+					{Name: "Arvel Crynyd"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp domain.PaginatedResponse[domain.Person]
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Equal(t, 1, resp.Count)
+				require.Len(t, resp.Results, 1)
+				assert.Equal(t, "Luke Skywalker", resp.Results[0].Name)
+			},
+		},
+		{
+			name: "invalid ranges are rejected",
+			url:  "/people?massMin=100&massMax=50&createdFrom=yesterday&includeUnknown=maybe",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+				assert.Contains(t, resp.Error.Details, "massMin")
+				assert.Contains(t, resp.Error.Details, "createdFrom")
+				assert.Contains(t, resp.Error.Details, "includeUnknown")
+			},
+		},
+		{
+			name: "fuzzy search ranked by relevance",
+			url:  "/people?search=skywlker&match=fuzzy&sortBy=relevance",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{
+					{Name: "Darth Vader"},
+					{Name: "Luke Skywalker"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp domain.PaginatedResponse[domain.Person]
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				require.Len(t, resp.Results, 1)
+				assert.Equal(t, "Luke Skywalker", resp.Results[0].Name)
+				assert.InDelta(t, 0.889, resp.Results[0].Relevance, 0.001)
+			},
+		},
+		{
+			name: "threshold out of range",
+			url:  "/people?search=luke&match=fuzzy&threshold=1.5",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+				assert.Contains(t, resp.Error.Details, "threshold")
+			},
+		},
+		{
+			name: "searchIn field not supported",
+			url:  "/people?search=x&searchIn=name,vehicles",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+				assert.Contains(t, resp.Error.Details, "searchIn")
+			},
+		},
+		{
+			name: "invalid mode",
+			url:  "/people?mode=everything",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+				assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+				assert.Contains(t, resp.Error.Details, "mode")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -106,12 +465,12 @@ func TestPeopleHandler_ListPeople(t *testing.T) {
 				tt.setupMock(mockRepo)
 			}
 
-			service := services.NewPeopleService(mockRepo)
+			service := services.NewPeopleService(mockRepo, services.NewCollectionCache(0, mockRepo.APIRetrieveAllPeople), nil, nil, services.ListSettings{PageSize: 15})
 			handler := NewPeopleHandler(service)
 
 			// Create router with middleware
 			router := gin.New()
-			router.Use(middleware.PaginationMiddleware())
+			router.Use(middleware.PaginationMiddleware(testPagination))
 			router.Use(middleware.QueryMiddleware())
 			router.GET("/people", handler.ListPeople)
 
@@ -129,3 +488,183 @@ func TestPeopleHandler_ListPeople(t *testing.T) {
 		})
 	}
 }
+
+// TestPeopleHandler_GetPersonByID - Unit test with mocks
+func TestPeopleHandler_GetPersonByID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		url            string
+		setupMock      func(m *mocks.MockSwapiRepository)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "get person successfully",
+			url:  "/people/1",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("APIRetrievePersonByID", mock.Anything, "1").Return(domain.Person{Name: "Luke Skywalker"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "person not found",
+			url:  "/people/9999",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("APIRetrievePersonByID", mock.Anything, "9999").Return(domain.Person{}, errDomain.ErrPersonNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "PERSON_NOT_FOUND",
+		},
+		{
+			name:           "non-numeric id rejected before upstream call",
+			url:            "/people/luke",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "negative id rejected before upstream call",
+			url:            "/people/-1",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name: "get person with expanded homeworld",
+			url:  "/people/1?expand=homeworld",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("APIRetrievePersonByID", mock.Anything, "1").Return(domain.Person{
+					Name:      "Luke Skywalker",
+					Homeworld: "https://swapi.dev/api/planets/1/",
+				}, nil)
+				m.On("FetchPlanetByID", mock.Anything, "1").Return(domain.Planet{Name: "Tatooine"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "encoded characters rejected before upstream call",
+			url:            "/people/1%3Fsearch=vader",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			resolver := services.NewRelationResolver(mockRepo, mockRepo, mockRepo, 10, 2)
+			handler := NewPeopleHandler(services.NewPeopleService(mockRepo, services.NewCollectionCache(0, mockRepo.APIRetrieveAllPeople), resolver, nil, services.ListSettings{PageSize: 15}))
+
+			router := gin.New()
+			router.Use(middleware.QueryMiddleware())
+			router.GET("/people/:id", handler.GetPersonByID)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedCode != "" {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tt.expectedCode, resp.Error.Code)
+			} else {
+				var person domain.Person
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &person))
+				assert.Equal(t, "Luke Skywalker", person.Name)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// TestPeopleHandler_ListPersonFilms - Unit test with mocks
+func TestPeopleHandler_ListPersonFilms(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		url            string
+		setupMock      func(m *mocks.MockSwapiRepository)
+		expectedStatus int
+		expectedCode   string
+		wantTitles     []string
+	}{
+		{
+			name: "list films sorted by episode",
+			url:  "/people/1/films?sortBy=episode&sortOrder=asc",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("APIRetrievePersonByID", mock.Anything, "1").Return(domain.Person{
+					Name:  "Luke Skywalker",
+					Films: []string{"https://swapi.dev/api/films/2/", "https://swapi.dev/api/films/1/"},
+				}, nil)
+				m.On("FetchFilmByID", mock.Anything, "1").Return(domain.Film{Title: "A New Hope", EpisodeID: 4}, nil)
+				m.On("FetchFilmByID", mock.Anything, "2").Return(domain.Film{Title: "The Empire Strikes Back", EpisodeID: 5}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			wantTitles:     []string{"A New Hope", "The Empire Strikes Back"},
+		},
+		{
+			name: "person not found",
+			url:  "/people/999/films",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("APIRetrievePersonByID", mock.Anything, "999").Return(domain.Person{}, errDomain.ErrPersonNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "PERSON_NOT_FOUND",
+		},
+		{
+			name:           "people sort field rejected for films",
+			url:            "/people/1/films?sortBy=mass",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			resolver := services.NewRelationResolver(mockRepo, mockRepo, mockRepo, 10, 2)
+			handler := NewPeopleHandler(services.NewPeopleService(mockRepo, services.NewCollectionCache(0, mockRepo.APIRetrieveAllPeople), resolver, nil, services.ListSettings{PageSize: 15}))
+
+			router := gin.New()
+			router.Use(middleware.PaginationMiddleware(testPagination))
+			router.Use(middleware.QueryMiddleware())
+			router.GET("/people/:id/films", handler.ListPersonFilms)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedCode != "" {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tt.expectedCode, resp.Error.Code)
+			}
+
+			if tt.wantTitles != nil {
+				var resp domain.PaginatedResponse[domain.Film]
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				titles := make([]string, len(resp.Results))
+				for i, film := range resp.Results {
+					titles[i] = film.Title
+				}
+				assert.Equal(t, tt.wantTitles, titles)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
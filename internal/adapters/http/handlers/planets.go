@@ -1,6 +1,10 @@
 package handlers
 
-import "github.com/stressedbypull/swapi-connector/internal/ports"
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
+	"github.com/stressedbypull/swapi-connector/internal/ports"
+)
 
 // PlanetHandler handles HTTP requests for planet resources.
 type PlanetHandler struct {
@@ -13,3 +17,176 @@ func NewPlanetHandler(service ports.PlanetServiceInterface) *PlanetHandler {
 		service: service,
 	}
 }
+
+// ListPlanets godoc
+// @Summary      List Star Wars planets
+// @Description  Get a paginated list of planets from SWAPI with optional search and sorting
+// @Tags         planets
+// @Accept       json
+// @Produce      json
+// @Param        page       query     int     false  "Page number"           default(1)       example(1)
+// @Param        pageSize   query     int     false  "Items per page (1-100 by default, configurable)"  default(15)  example(25)
+// @Param        limit      query     int     false  "Items per page for cursor pagination (1-100); replaces page"  example(10)
+// @Param        cursor     query     string  false  "nextCursor or prevCursor of a previous response, with the same search, filter and sort parameters"
+// @Param        search     query     string  false  "Search by name"        example(tatooine)
+// @Param        match      query     string  false  "Search matching: contains, or fuzzy with relevance scores"  Enums(contains, fuzzy)  default(contains)
+// @Param        threshold  query     number  false  "Minimum fuzzy relevance in (0, 1]"  default(0.7)  example(0.7)
+// @Param        filter     query     string  false  "Filter expression, e.g. population>1000000"  example(population>1000000)
+// @Param        populationMin   query     number  false  "Minimum population (inclusive)"  example(1000000)
+// @Param        populationMax   query     number  false  "Maximum population (inclusive)"  example(1000000000)
+// @Param        diameterMin     query     number  false  "Minimum diameter in km (inclusive)"  example(10000)
+// @Param        diameterMax     query     number  false  "Maximum diameter in km (inclusive)"  example(15000)
+// @Param        createdFrom     query     string  false  "Created on or after (YYYY-MM-DD)"  example(2014-12-09)
+// @Param        createdTo       query     string  false  "Created on or before (YYYY-MM-DD)"  example(2014-12-20)
+// @Param        includeUnknown  query     bool    false  "Keep records whose ranged value is unknown"  default(false)
+// @Param        sort       query     string  false  "Multi-key sort (field:asc|desc, comma-separated); replaces sortBy/sortOrder"  example(diameter:desc,name)
+// @Param        sortBy     query     string  false  "Sort field"  example(name)
+// @Param        sortOrder  query     string  false  "Sort order"            Enums(asc, desc)            default(asc)  example(asc)
+// @Param        locale     query     string  false  "Collation locale for name sorting (BCP 47)"  default(en)  example(sv)
+// @Param        nulls      query     string  false  "Where unknown numeric values sort, whatever the direction"  Enums(first, last)  default(last)
+// @Param        expand     query     string  false  "Relations to embed (comma-separated)"  Enums(residents, films)  example(residents)
+// @Param        mode       query     string  false  "List mode: page filters/sorts one upstream page, collection the whole collection"  Enums(page, collection)  example(collection)
+// @Success      200  {object}  PlanetListResponse  "Successful response with planet list"
+// @Failure      400  {object}  ErrorResponse       "Invalid request parameters"
+// @Failure      404  {object}  ErrorResponse       "Planet not found"
+// @Failure      500  {object}  ErrorResponse       "Internal server error"
+// @Router       /planets [get]
+func (h *PlanetHandler) ListPlanets(c *gin.Context) {
+	// Parse and validate query parameters
+	params, ok := ParsePlanetQueryParams(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	q, cursor, ok := parseCursor(c, params.ListQuery())
+	if !ok {
+		return // Validation error already sent
+	}
+
+	// Call service
+	result, err := h.service.ListPlanets(c.Request.Context(), q)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	linkPages(c, &result, params.PageSize, cursor)
+	response.OK(c, result)
+}
+
+// GetPlanetByID godoc
+// @Summary      Get a Star Wars planet
+// @Description  Get a single planet from SWAPI by its numeric ID
+// @Tags         planets
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Planet ID"  example(1)
+// @Param        expand  query     string  false  "Relations to embed (comma-separated)"  Enums(residents, films)  example(residents)
+// @Success      200  {object}  Planet         "Successful response with planet"
+// @Failure      400  {object}  ErrorResponse  "Invalid planet ID"
+// @Failure      404  {object}  ErrorResponse  "Planet not found"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Router       /planets/{id} [get]
+func (h *PlanetHandler) GetPlanetByID(c *gin.Context) {
+	// Validate ID before calling upstream
+	id, ok := ParseResourceID(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	expand, ok := ParsePlanetExpand(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	planet, err := h.service.GetPlanetByID(c.Request.Context(), id, expand)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, planet)
+}
+
+// ListPlanetResidents godoc
+// @Summary      List the residents of a planet
+// @Description  Resolve a planet's resident URLs into full people, with optional search, sorting and pagination
+// @Tags         planets
+// @Accept       json
+// @Produce      json
+// @Param        id         path      int     true   "Planet ID"             example(1)
+// @Param        page       query     int     false  "Page number"           default(1)       example(1)
+// @Param        pageSize   query     int     false  "Items per page (1-100 by default, configurable)"  default(15)  example(25)
+// @Param        limit      query     int     false  "Items per page for cursor pagination (1-100); replaces page"  example(10)
+// @Param        cursor     query     string  false  "nextCursor or prevCursor of a previous response, with the same search, filter and sort parameters"
+// @Param        search     query     string  false  "Search by name"        example(skywalker)
+// @Param        match      query     string  false  "Search matching: contains, or fuzzy with relevance scores"  Enums(contains, fuzzy)  default(contains)
+// @Param        threshold  query     number  false  "Minimum fuzzy relevance in (0, 1]"  default(0.7)  example(0.7)
+// @Param        searchIn   query     string  false  "Fields to search (comma-separated)"  Enums(name, homeworld, films, species)  example(homeworld)
+// @Param        filter     query     string  false  "Filter expression, e.g. mass>80 and gender=male"  example(mass>80)
+// @Param        massMin         query     number  false  "Minimum mass in kg (inclusive)"  example(50)
+// @Param        massMax         query     number  false  "Maximum mass in kg (inclusive)"  example(100)
+// @Param        heightMin       query     number  false  "Minimum height in cm (inclusive)"  example(150)
+// @Param        heightMax       query     number  false  "Maximum height in cm (inclusive)"  example(200)
+// @Param        createdFrom     query     string  false  "Created on or after (YYYY-MM-DD)"  example(2014-12-09)
+// @Param        createdTo       query     string  false  "Created on or before (YYYY-MM-DD)"  example(2014-12-20)
+// @Param        editedFrom      query     string  false  "Edited on or after (YYYY-MM-DD)"  example(2014-12-09)
+// @Param        editedTo        query     string  false  "Edited on or before (YYYY-MM-DD)"  example(2014-12-20)
+// @Param        includeUnknown  query     bool    false  "Keep records whose ranged value is unknown"  default(false)
+// @Param        sort       query     string  false  "Multi-key sort (field:asc|desc, comma-separated); replaces sortBy/sortOrder"  example(mass:desc,name)
+// @Param        sortBy     query     string  false  "Sort field"  example(name)
+// @Param        sortOrder  query     string  false  "Sort order"            Enums(asc, desc)            default(asc)  example(asc)
+// @Param        locale     query     string  false  "Collation locale for name sorting (BCP 47)"  default(en)  example(sv)
+// @Param        nulls      query     string  false  "Where unknown numeric values sort, whatever the direction"  Enums(first, last)  default(last)
+// @Success      200  {object}  PeopleListResponse  "Successful response with the planet's residents"
+// @Failure      400  {object}  ErrorResponse       "Invalid request parameters"
+// @Failure      404  {object}  ErrorResponse       "Planet not found"
+// @Failure      500  {object}  ErrorResponse       "Internal server error"
+// @Router       /planets/{id}/residents [get]
+func (h *PlanetHandler) ListPlanetResidents(c *gin.Context) {
+	// Validate ID before calling upstream
+	id, ok := ParseResourceID(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	// Nested residents sort like the top-level people endpoint
+	params, ok := parseListQueryParams(c, allowedPeopleSortBy)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	match, ok := ParsePeopleSearchMatch(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	filter, ok := ParsePeopleFilter(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	ranges, ok := ParsePeopleRanges(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	q := params.ListQuery()
+	q.Filter = filter
+	q.Ranges = ranges
+	match.Apply(&q)
+
+	q, cursor, ok := parseCursor(c, q)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	result, err := h.service.ListPlanetResidents(c.Request.Context(), id, q)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	linkPages(c, &result, params.PageSize, cursor)
+	response.OK(c, result)
+}
@@ -0,0 +1,349 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	errDomain "github.com/stressedbypull/swapi-connector/internal/errors"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stressedbypull/swapi-connector/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPlanetHandler_ListPlanets - Unit test with mocks
+func TestPlanetHandler_ListPlanets(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		url            string
+		setupMock      func(m *mocks.MockSwapiRepository)
+		expectedStatus int
+		checkResponse  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "list planets successfully",
+			url:  "/planets",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				mockResp := domain.PaginatedResponse[domain.Planet]{
+					Count: 2,
+					Page:  1,
+					Results: []domain.Planet{
+						{Name: "Tatooine", Created: "2014-12-09"},
+						{Name: "Alderaan", Created: "2014-12-10"},
+					},
+				}
+				m.On("FetchPlanets", mock.Anything, 1, 15, "").Return(mockResp, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp domain.PaginatedResponse[domain.Planet]
+				err := json.Unmarshal(w.Body.Bytes(), &resp)
+				require.NoError(t, err)
+
+				assert.Equal(t, 2, resp.Count)
+				assert.Len(t, resp.Results, 2)
+				assert.Equal(t, "Tatooine", resp.Results[0].Name)
+			},
+		},
+		{
+			name: "sort by name descending",
+			url:  "/planets?sortBy=name&sortOrder=desc",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				mockResp := domain.PaginatedResponse[domain.Planet]{
+					Count: 2,
+					Page:  1,
+					Results: []domain.Planet{
+						{Name: "Alderaan"},
+						{Name: "Tatooine"},
+					},
+				}
+				m.On("FetchPlanets", mock.Anything, 1, 15, "").Return(mockResp, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp domain.PaginatedResponse[domain.Planet]
+				err := json.Unmarshal(w.Body.Bytes(), &resp)
+				require.NoError(t, err)
+
+				assert.Equal(t, "Tatooine", resp.Results[0].Name)
+				assert.Equal(t, "Alderaan", resp.Results[1].Name)
+			},
+		},
+		{
+			name: "sort by population descending",
+			url:  "/planets?sortBy=population&sortOrder=desc",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				mockResp := domain.PaginatedResponse[domain.Planet]{
+					Count: 2,
+					Page:  1,
+					Results: []domain.Planet{
+						{Name: "Tatooine", Population: domain.Measure(200000)},
+						{Name: "Alderaan", Population: domain.Measure(2000000000)},
+					},
+				}
+				m.On("FetchPlanets", mock.Anything, 1, 15, "").Return(mockResp, nil)
+			},
+			expectedStatus: http.StatusOK,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp domain.PaginatedResponse[domain.Planet]
+				err := json.Unmarshal(w.Body.Bytes(), &resp)
+				require.NoError(t, err)
+
+				assert.Equal(t, "Alderaan", resp.Results[0].Name)
+				assert.Equal(t, "Tatooine", resp.Results[1].Name)
+			},
+		},
+		{
+			name: "mass is not a valid planet sort field",
+			url:  "/planets?sortBy=mass",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				err := json.Unmarshal(w.Body.Bytes(), &resp)
+				require.NoError(t, err)
+
+				assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+				assert.Contains(t, resp.Error.Details, "sortBy")
+			},
+		},
+		{
+			name: "homeworld is not a valid planet expand relation",
+			url:  "/planets?expand=residents,homeworld",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				// No mock setup needed - validation happens before repository call
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var resp response.ErrorResponse
+				err := json.Unmarshal(w.Body.Bytes(), &resp)
+				require.NoError(t, err)
+
+				assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+				assert.Contains(t, resp.Error.Details, "expand")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			service := services.NewPlanetService(mockRepo, services.NewCollectionCache(0, mockRepo.FetchAllPlanets), nil, nil, services.ListSettings{PageSize: 15})
+			handler := NewPlanetHandler(service)
+
+			router := gin.New()
+			router.Use(middleware.PaginationMiddleware(testPagination))
+			router.Use(middleware.QueryMiddleware())
+			router.GET("/planets", handler.ListPlanets)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.checkResponse != nil {
+				tt.checkResponse(t, w)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// TestPlanetHandler_GetPlanetByID - Unit test with mocks
+func TestPlanetHandler_GetPlanetByID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		url            string
+		setupMock      func(m *mocks.MockSwapiRepository)
+		expectedStatus int
+		expectedCode   string
+	}{
+		{
+			name: "get planet successfully",
+			url:  "/planets/1",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchPlanetByID", mock.Anything, "1").Return(domain.Planet{Name: "Tatooine"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "get planet with expanded residents",
+			url:  "/planets/1?expand=residents",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchPlanetByID", mock.Anything, "1").Return(domain.Planet{
+					Name:     "Tatooine",
+					Resident: []string{"https://swapi.dev/api/people/1/"},
+				}, nil)
+				m.On("APIRetrievePersonByID", mock.Anything, "1").Return(domain.Person{Name: "Luke Skywalker"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unknown expand relation rejected before upstream call",
+			url:            "/planets/1?expand=species",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name: "planet not found",
+			url:  "/planets/999",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchPlanetByID", mock.Anything, "999").Return(domain.Planet{}, errDomain.ErrPlanetNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "PLANET_NOT_FOUND",
+		},
+		{
+			name:           "non-numeric id rejected before upstream call",
+			url:            "/planets/abc",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "zero id rejected before upstream call",
+			url:            "/planets/0",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			resolver := services.NewRelationResolver(mockRepo, mockRepo, mockRepo, 10, 2)
+			handler := NewPlanetHandler(services.NewPlanetService(mockRepo, services.NewCollectionCache(0, mockRepo.FetchAllPlanets), resolver, nil, services.ListSettings{PageSize: 15}))
+
+			router := gin.New()
+			router.Use(middleware.QueryMiddleware())
+			router.GET("/planets/:id", handler.GetPlanetByID)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedCode != "" {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tt.expectedCode, resp.Error.Code)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// TestPlanetHandler_ListPlanetResidents - Unit test with mocks
+func TestPlanetHandler_ListPlanetResidents(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		url            string
+		setupMock      func(m *mocks.MockSwapiRepository)
+		expectedStatus int
+		expectedCode   string
+		wantNames      []string
+	}{
+		{
+			name: "list residents sorted by name",
+			url:  "/planets/1/residents?sortBy=name&sortOrder=asc",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchPlanetByID", mock.Anything, "1").Return(domain.Planet{
+					Name:     "Tatooine",
+					Resident: []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/4/"},
+				}, nil)
+				m.On("APIRetrievePersonByID", mock.Anything, "1").Return(domain.Person{Name: "Luke Skywalker"}, nil)
+				m.On("APIRetrievePersonByID", mock.Anything, "4").Return(domain.Person{Name: "Darth Vader"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			wantNames:      []string{"Darth Vader", "Luke Skywalker"},
+		},
+		{
+			name: "planet not found",
+			url:  "/planets/999/residents",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchPlanetByID", mock.Anything, "999").Return(domain.Planet{}, errDomain.ErrPlanetNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedCode:   "PLANET_NOT_FOUND",
+		},
+		{
+			name:           "planet sort field rejected for residents",
+			url:            "/planets/1/residents?sortBy=population",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+		{
+			name:           "non-numeric id rejected before upstream call",
+			url:            "/planets/abc/residents",
+			expectedStatus: http.StatusBadRequest,
+			expectedCode:   "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			resolver := services.NewRelationResolver(mockRepo, mockRepo, mockRepo, 10, 2)
+			handler := NewPlanetHandler(services.NewPlanetService(mockRepo, services.NewCollectionCache(0, mockRepo.FetchAllPlanets), resolver, nil, services.ListSettings{PageSize: 15}))
+
+			router := gin.New()
+			router.Use(middleware.PaginationMiddleware(testPagination))
+			router.Use(middleware.QueryMiddleware())
+			router.GET("/planets/:id/residents", handler.ListPlanetResidents)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedCode != "" {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tt.expectedCode, resp.Error.Code)
+			}
+
+			if tt.wantNames != nil {
+				var resp domain.PaginatedResponse[domain.Person]
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				names := make([]string, len(resp.Results))
+				for i, person := range resp.Results {
+					names[i] = person.Name
+				}
+				assert.Equal(t, tt.wantNames, names)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
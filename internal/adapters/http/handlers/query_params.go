@@ -1,67 +1,661 @@
 package handlers
 
 import (
+	stderrors "errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
 	"github.com/gin-gonic/gin"
 	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
 	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
 	"github.com/stressedbypull/swapi-connector/internal/adapters/http/validation"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/search"
+	"github.com/stressedbypull/swapi-connector/internal/sorting"
 )
 
-// Allowed values for people endpoint
+// Allowed values for list endpoints
 var (
-	allowedPeopleSortBy = []string{"name", "created", "mass"} // Fields that can be sorted
-	allowedSortOrder    = []string{"asc", "desc"}             // Sort directions
+	// Fields that can be sorted, per resource (registered in the sorting package)
+	allowedPeopleSortBy   = sorting.PersonSorters.Fields()
+	allowedPlanetSortBy   = sorting.PlanetSorters.Fields()
+	allowedFilmSortBy     = sorting.FilmSorters.Fields()
+	allowedStarshipSortBy = sorting.StarshipSorters.Fields()
+	allowedVehicleSortBy  = sorting.VehicleSorters.Fields()
+	allowedSpeciesSortBy  = sorting.SpeciesSorters.Fields()
+
+	// Sort directions
+	allowedSortOrder = []string{"asc", "desc"}
+
+	// Placement of unknown numeric values (?nulls=), applied to every sort key
+	allowedNulls = []string{string(domain.NullsFirst), string(domain.NullsLast)}
+
+	// Relations that can be embedded via ?expand=, per resource
+	allowedPeopleExpand = []string{"films", "homeworld"}
+	allowedPlanetExpand = []string{"residents", "films"}
+
+	// Search match modes: substring or fuzzy with relevance scores
+	allowedMatch = []string{string(domain.MatchContains), string(domain.MatchFuzzy)}
+
+	// Fields the search term can be matched against via ?searchIn=, per resource
+	allowedPeopleSearchIn = []string{"name", "homeworld", "films", "species"}
+	allowedPlanetSearchIn = []string{"name"}
+
+	// Fields that can be bounded via ?<field>Min=/?<field>Max= (numbers)
+	// and ?<field>From=/?<field>To= (dates, YYYY-MM-DD), per resource
+	allowedPeopleNumberRanges = []string{"mass", "height"}
+	allowedPeopleDateRanges   = []string{"created", "edited"}
+	allowedPlanetNumberRanges = []string{"population", "diameter"}
+	allowedPlanetDateRanges   = []string{"created"}
+
+	// Values of ?includeUnknown=
+	allowedIncludeUnknown = []string{"true", "false"}
+
+	// Resource types ?type= can restrict suggestions to
+	allowedSuggestTypes = []string{"people", "planets", "films", "species"}
+
+	// List modes: filter/sort one upstream page, or the complete collection
+	allowedListMode = []string{string(domain.ListModePage), string(domain.ListModeCollection)}
+)
+
+// SortFieldsByRoute returns the sortable fields of every list route, keyed by the
+// route as it appears in the OpenAPI docs. The docs advertise these as the sort enums.
+func SortFieldsByRoute() map[string][]string {
+	return map[string][]string{
+		"/people":                 allowedPeopleSortBy,
+		"/people/{id}/films":      allowedFilmSortBy,
+		"/planets":                allowedPlanetSortBy,
+		"/planets/{id}/residents": allowedPeopleSortBy,
+		"/films":                  allowedFilmSortBy,
+		"/starships":              allowedStarshipSortBy,
+		"/vehicles":               allowedVehicleSortBy,
+		"/species":                allowedSpeciesSortBy,
+	}
+}
+
+// Suggestion limits
+const (
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = 50
 )
 
+// ListQueryParams holds the validated query parameters shared by all list endpoints.
+type ListQueryParams struct {
+	Page     int              // Which page, a positive integer (default: 1)
+	PageSize int              // Items per page, within the configured bounds (default: configured page size)
+	Search   string           // Text to search in names (optional)
+	Sort     []domain.SortKey // Sort keys from ?sort= or ?sortBy=/?sortOrder=, with ?nulls= and ?locale= applied, validated per resource (optional)
+}
+
 // PeopleQueryParams holds the validated query parameters for the people endpoint.
 type PeopleQueryParams struct {
-	Page      int    // Which page (from pagination middleware)
-	Search    string // Text to search in names (optional)
-	SortBy    string // Field to sort by: "name", "created", or "mass" (optional)
-	SortOrder string // Sort direction: "asc" or "desc" (default: "asc")
+	ListQueryParams
+	Match  SearchMatch        // How search is matched: contains (default) or fuzzy
+	Filter string             // Validated filter expression (optional)
+	Ranges domain.RangeFilter // Mass/height and created/edited ranges (optional)
+	Expand []string           // Relations to embed: films, homeworld (optional)
+	Mode   domain.ListMode    // "page" or "collection"; empty uses the server default (optional)
+}
+
+// PlanetQueryParams holds the validated query parameters for the planets endpoint.
+type PlanetQueryParams struct {
+	ListQueryParams
+	Match  SearchMatch        // How search is matched: contains (default) or fuzzy
+	Filter string             // Validated filter expression (optional)
+	Ranges domain.RangeFilter // Population/diameter and created ranges (optional)
+	Expand []string           // Relations to embed: residents, films (optional)
+	Mode   domain.ListMode    // "page" or "collection"; empty uses the server default (optional)
+}
+
+// SearchMatch holds the validated search matching options.
+type SearchMatch struct {
+	Mode      domain.MatchMode // "contains" or "fuzzy"; empty behaves like contains
+	Threshold float64          // Minimum fuzzy relevance in (0, 1]; 0 uses the default
+	Fields    []string         // Fields to search (?searchIn=); empty means name only
+}
+
+// FilmQueryParams holds the validated query parameters for the films endpoint.
+type FilmQueryParams struct {
+	ListQueryParams
+}
+
+// StarshipQueryParams holds the validated query parameters for the starships endpoint.
+type StarshipQueryParams struct {
+	ListQueryParams
+}
+
+// VehicleQueryParams holds the validated query parameters for the vehicles endpoint.
+type VehicleQueryParams struct {
+	ListQueryParams
+}
+
+// SpeciesQueryParams holds the validated query parameters for the species endpoint.
+type SpeciesQueryParams struct {
+	ListQueryParams
+	Filter domain.SpeciesFilter // Exact-match classification/designation/language filters (optional)
 }
 
 // ParsePeopleQueryParams gets query parameters from middleware and validates them.
 //
 // Flow:
-//  1. Get page number (already validated by pagination middleware)
+//  1. Validate page is a positive integer (default: 1) and pageSize is within the configured bounds
 //  2. Get search/sort values (from query middleware)
-//  3. Validate sortBy is one of: name, created, mass
-//  4. Validate sortOrder is one of: asc, desc
-//  5. Return validated params OR send error response
+//  3. Validate each sort key (or sortBy) is a registered person sort field
+//  4. Validate each direction (or sortOrder) is one of: asc, desc, nulls is one of: first, last,
+//     and locale is a BCP 47 language tag
+//  5. Validate match is one of: contains, fuzzy, threshold is in (0, 1],
+//     and each searchIn field is one of: name, homeworld, films, species
+//  6. Validate the filter expression against the people fields
+//  7. Validate the mass/height and created/edited ranges and includeUnknown
+//  8. Validate each expand item is one of: films, homeworld
+//  9. Validate mode is one of: page, collection
+//  10. Return validated params OR send error response
 //
 // Returns:
 //   - PeopleQueryParams: the validated parameters
 //   - bool: true if valid, false if validation failed (error already sent to client)
 func ParsePeopleQueryParams(c *gin.Context) (PeopleQueryParams, bool) {
-	// Step 1: Get page number (middleware already checked it's >= 1)
-	paginationParams := middleware.GetPaginationParams(c)
+	params, ok := parseListQueryParams(c, allowedPeopleSortBy)
+	if !ok {
+		return PeopleQueryParams{}, false
+	}
+
+	match, ok := ParsePeopleSearchMatch(c)
+	if !ok {
+		return PeopleQueryParams{}, false
+	}
+
+	filter, ok := ParsePeopleFilter(c)
+	if !ok {
+		return PeopleQueryParams{}, false
+	}
+
+	ranges, ok := ParsePeopleRanges(c)
+	if !ok {
+		return PeopleQueryParams{}, false
+	}
+
+	expand, ok := ParsePeopleExpand(c)
+	if !ok {
+		return PeopleQueryParams{}, false
+	}
+
+	mode, ok := parseListMode(c)
+	return PeopleQueryParams{ListQueryParams: params, Match: match, Filter: filter, Ranges: ranges, Expand: expand, Mode: mode}, ok
+}
+
+// ParsePlanetQueryParams gets query parameters from middleware and validates them
+// against the planet-specific allowed values.
+func ParsePlanetQueryParams(c *gin.Context) (PlanetQueryParams, bool) {
+	params, ok := parseListQueryParams(c, allowedPlanetSortBy)
+	if !ok {
+		return PlanetQueryParams{}, false
+	}
+
+	match, ok := parseSearchMatch(c, allowedPlanetSearchIn)
+	if !ok {
+		return PlanetQueryParams{}, false
+	}
+
+	filter, ok := ParsePlanetFilter(c)
+	if !ok {
+		return PlanetQueryParams{}, false
+	}
+
+	ranges, ok := parseRanges(c, allowedPlanetNumberRanges, allowedPlanetDateRanges)
+	if !ok {
+		return PlanetQueryParams{}, false
+	}
+
+	expand, ok := ParsePlanetExpand(c)
+	if !ok {
+		return PlanetQueryParams{}, false
+	}
+
+	mode, ok := parseListMode(c)
+	return PlanetQueryParams{ListQueryParams: params, Match: match, Filter: filter, Ranges: ranges, Expand: expand, Mode: mode}, ok
+}
+
+// ParsePeopleExpand validates the ?expand= relations requested on people endpoints.
+func ParsePeopleExpand(c *gin.Context) ([]string, bool) {
+	return parseExpand(c, allowedPeopleExpand)
+}
+
+// ParsePlanetExpand validates the ?expand= relations requested on planet endpoints.
+func ParsePlanetExpand(c *gin.Context) ([]string, bool) {
+	return parseExpand(c, allowedPlanetExpand)
+}
+
+// ParsePeopleFilter validates the ?filter= expression against the people fields.
+func ParsePeopleFilter(c *gin.Context) (string, bool) {
+	return parseFilter(c, search.ValidatePeopleExpression)
+}
+
+// ParsePlanetFilter validates the ?filter= expression against the planet fields.
+func ParsePlanetFilter(c *gin.Context) (string, bool) {
+	return parseFilter(c, search.ValidatePlanetExpression)
+}
+
+// ParsePeopleRanges validates the mass/height and created/edited ranges of people endpoints.
+func ParsePeopleRanges(c *gin.Context) (domain.RangeFilter, bool) {
+	return parseRanges(c, allowedPeopleNumberRanges, allowedPeopleDateRanges)
+}
+
+// ParsePeopleSearchMatch validates the search options of people endpoints,
+// which can also search homeworld, film and species names.
+func ParsePeopleSearchMatch(c *gin.Context) (SearchMatch, bool) {
+	return parseSearchMatch(c, allowedPeopleSearchIn)
+}
+
+// parseSearchMatch validates the optional ?match=, ?threshold= and ?searchIn= parameters.
+// On failure the error response is already sent.
+func parseSearchMatch(c *gin.Context, allowedSearchIn []string) (SearchMatch, bool) {
+	queryParams := middleware.GetQueryParams(c)
+
+	validator := validation.New()
+	validator.ValidateOneOf("match", queryParams.Match, allowedMatch)
+	validator.ValidateFloatRange("threshold", queryParams.Threshold, 0, 1)
+	for _, field := range queryParams.SearchIn {
+		validator.ValidateOneOf("searchIn", field, allowedSearchIn)
+	}
+
+	if validator.HasErrors() {
+		response.ValidationError(c, validator.ErrorsMap())
+		return SearchMatch{}, false
+	}
+
+	// Already validated above
+	threshold, _ := strconv.ParseFloat(queryParams.Threshold, 64)
+
+	return SearchMatch{
+		Mode:      domain.MatchMode(queryParams.Match),
+		Threshold: threshold,
+		Fields:    queryParams.SearchIn,
+	}, true
+}
+
+// ParseFilmFilter validates the ?filter= expression against the film fields.
+func ParseFilmFilter(c *gin.Context) (string, bool) {
+	return parseFilter(c, search.ValidateFilmExpression)
+}
+
+// ParseFilmQueryParams gets query parameters from middleware and validates them
+// against the film-specific allowed values. Cursor pagination is rejected.
+func ParseFilmQueryParams(c *gin.Context) (FilmQueryParams, bool) {
+	if !rejectCursor(c) {
+		return FilmQueryParams{}, false
+	}
+
+	params, ok := parseListQueryParams(c, allowedFilmSortBy)
+	return FilmQueryParams{ListQueryParams: params}, ok
+}
+
+// ParseStarshipQueryParams gets query parameters from middleware and validates them
+// against the starship-specific allowed values. Cursor pagination is rejected.
+func ParseStarshipQueryParams(c *gin.Context) (StarshipQueryParams, bool) {
+	if !rejectCursor(c) {
+		return StarshipQueryParams{}, false
+	}
+
+	params, ok := parseListQueryParams(c, allowedStarshipSortBy)
+	return StarshipQueryParams{ListQueryParams: params}, ok
+}
+
+// ParseVehicleQueryParams gets query parameters from middleware and validates them
+// against the vehicle-specific allowed values. Cursor pagination is rejected.
+func ParseVehicleQueryParams(c *gin.Context) (VehicleQueryParams, bool) {
+	if !rejectCursor(c) {
+		return VehicleQueryParams{}, false
+	}
+
+	params, ok := parseListQueryParams(c, allowedVehicleSortBy)
+	return VehicleQueryParams{ListQueryParams: params}, ok
+}
+
+// ParseSpeciesQueryParams gets query parameters from middleware and validates them
+// against the species-specific allowed values; cursor pagination is rejected. The classification, designation and
+// language filters are read directly from the query string since only species support them.
+func ParseSpeciesQueryParams(c *gin.Context) (SpeciesQueryParams, bool) {
+	if !rejectCursor(c) {
+		return SpeciesQueryParams{}, false
+	}
+
+	params, ok := parseListQueryParams(c, allowedSpeciesSortBy)
+	if !ok {
+		return SpeciesQueryParams{}, false
+	}
+
+	return SpeciesQueryParams{
+		ListQueryParams: params,
+		Filter: domain.SpeciesFilter{
+			Classification: strings.TrimSpace(c.Query("classification")),
+			Designation:    strings.TrimSpace(c.Query("designation")),
+			Language:       strings.TrimSpace(c.Query("language")),
+		},
+	}, true
+}
 
-	// Step 2: Get search and sort parameters (not validated yet)
+// parseListQueryParams validates the shared list parameters against the
+// resource's allowed sort fields. On failure the error response is already sent.
+func parseListQueryParams(c *gin.Context, allowedSortBy []string) (ListQueryParams, bool) {
+	// Step 1: Get page, search and sort parameters (not validated yet)
+	paginationParams := middleware.GetPaginationParams(c)
 	queryParams := middleware.GetQueryParams(c)
 
-	// Step 3: Validate the parameters
+	// Step 2: Validate the parameters
 	validator := validation.New()
+	var sort []domain.SortKey
+
+	page := middleware.DefaultPage
+	if paginationParams.Page != "" && validator.ValidatePositiveInt("page", paginationParams.Page) {
+		page, _ = strconv.Atoi(paginationParams.Page) // Already validated above
+	}
 
-	// Only validate sortBy if user provided it
-	if queryParams.SortBy != "" {
-		validator.ValidateOneOf("sortBy", queryParams.SortBy, allowedPeopleSortBy)
+	settings := paginationParams.Settings
+	pageSize := settings.DefaultPageSize
+	if paginationParams.PageSize != "" && validator.ValidateIntRange("pageSize", paginationParams.PageSize, settings.MinPageSize, settings.MaxPageSize) {
+		pageSize, _ = strconv.Atoi(paginationParams.PageSize) // Already validated above
 	}
 
-	// Always validate sortOrder (has default "asc")
-	validator.ValidateOneOf("sortOrder", queryParams.SortOrder, allowedSortOrder)
+	if len(queryParams.Sort) > 0 {
+		// Multi-key ?sort=mass:desc,name takes the place of sortBy/sortOrder
+		if queryParams.SortBy != "" {
+			validator.AddError("sort", "cannot be combined with sortBy", strings.Join(queryParams.Sort, ","))
+		} else {
+			sort = parseSortKeys(validator, queryParams.Sort, allowedSortBy)
+		}
+	} else {
+		// Only validate sortBy if user provided it
+		if queryParams.SortBy != "" {
+			validator.ValidateOneOf("sortBy", queryParams.SortBy, allowedSortBy)
+			sort = []domain.SortKey{{Field: queryParams.SortBy, Descending: queryParams.SortOrder == "desc"}}
+		}
+
+		// Always validate sortOrder (has default "asc")
+		validator.ValidateOneOf("sortOrder", queryParams.SortOrder, allowedSortOrder)
+	}
+
+	// ?nulls= places unknown values for every key; non-numeric keys ignore it
+	if validator.ValidateOneOf("nulls", queryParams.Nulls, allowedNulls) {
+		for i := range sort {
+			sort[i].Nulls = domain.NullsPlacement(queryParams.Nulls)
+		}
+	}
 
-	// Step 4: If validation failed, send error to client and return false
+	// ?locale= collates every name key; other keys ignore it
+	if locale, ok := parseLocale(validator, queryParams.Locale); ok {
+		for i := range sort {
+			sort[i].Locale = locale
+		}
+	}
+
+	// Step 3: If validation failed, send error to client and return false
 	if validator.HasErrors() {
 		response.ValidationError(c, validator.ErrorsMap())
-		return PeopleQueryParams{}, false
+		return ListQueryParams{}, false
 	}
 
-	// Step 5: All good! Return the validated parameters
-	return PeopleQueryParams{
-		Page:      paginationParams.Page,
-		Search:    queryParams.Search,
-		SortBy:    queryParams.SortBy,
-		SortOrder: queryParams.SortOrder,
+	// Step 4: All good! Return the validated parameters
+	return ListQueryParams{
+		Page:     page,
+		PageSize: pageSize,
+		Search:   queryParams.Search,
+		Sort:     sort,
 	}, true
 }
+
+// parseSortKeys parses ?sort= keys of the form "field" or "field:asc|desc" (default asc).
+// Every unsupported field, invalid direction and repeated field is named in a single "sort" error.
+func parseSortKeys(validator *validation.Validator, raw []string, allowedSortBy []string) []domain.SortKey {
+	var (
+		keys        []domain.SortKey
+		unsupported []string
+		badOrders   []string
+		repeated    []string
+		seen        = make(map[string]bool, len(raw))
+	)
+
+	for _, item := range raw {
+		field, order, _ := strings.Cut(item, ":")
+		field, order = strings.TrimSpace(field), strings.TrimSpace(order)
+
+		switch {
+		case !slices.Contains(allowedSortBy, field):
+			unsupported = append(unsupported, field)
+		case order != "" && !slices.Contains(allowedSortOrder, order):
+			badOrders = append(badOrders, item)
+		case seen[field]:
+			repeated = append(repeated, field)
+		default:
+			seen[field] = true
+			keys = append(keys, domain.SortKey{Field: field, Descending: order == "desc"})
+		}
+	}
+
+	var problems []string
+	if len(unsupported) > 0 {
+		problems = append(problems, fmt.Sprintf("unsupported sort keys: %s (allowed: %s)", strings.Join(unsupported, ", "), strings.Join(allowedSortBy, ", ")))
+	}
+	if len(badOrders) > 0 {
+		problems = append(problems, fmt.Sprintf("invalid directions in: %s (allowed: %s)", strings.Join(badOrders, ", "), strings.Join(allowedSortOrder, ", ")))
+	}
+	if len(repeated) > 0 {
+		problems = append(problems, fmt.Sprintf("repeated sort keys: %s", strings.Join(repeated, ", ")))
+	}
+	if len(problems) > 0 {
+		validator.AddError("sort", strings.Join(problems, "; "), strings.Join(raw, ","))
+		return nil
+	}
+
+	return keys
+}
+
+// parseLocale validates the optional ?locale= collation locale and returns it in
+// canonical collation form (e.g. "en-us" -> "en-US", "en-GB" -> "en"). Empty means the default locale.
+func parseLocale(validator *validation.Validator, raw string) (string, bool) {
+	if raw == "" {
+		return "", true
+	}
+
+	locale, err := sorting.ParseLocale(raw)
+	if err != nil {
+		validator.AddError("locale", "must be a supported BCP 47 language tag, e.g. en, de or sv", raw)
+		return "", false
+	}
+	return locale.String(), true
+}
+
+// parseExpand validates every requested relation against the resource's allowed
+// relations. On failure the error response is already sent.
+func parseExpand(c *gin.Context, allowed []string) ([]string, bool) {
+	expand := middleware.GetQueryParams(c).Expand
+
+	validator := validation.New()
+	for _, relation := range expand {
+		validator.ValidateOneOf("expand", relation, allowed)
+	}
+
+	if validator.HasErrors() {
+		response.ValidationError(c, validator.ErrorsMap())
+		return nil, false
+	}
+
+	return expand, true
+}
+
+// parseFilter validates the optional ?filter= expression with the resource's validate function.
+// Syntax and field errors are reported with the position of the offending token.
+// On failure the error response is already sent.
+func parseFilter(c *gin.Context, validate func(expr string) error) (string, bool) {
+	filter := strings.TrimSpace(middleware.GetQueryParams(c).Filter)
+	if filter == "" {
+		return "", true
+	}
+
+	validator := validation.New()
+	if err := validate(filter); err != nil {
+		var parseErr *search.ParseError
+		if stderrors.As(err, &parseErr) {
+			validator.AddError("filter", parseErr.Error(), parseErr.Token)
+		} else {
+			validator.AddError("filter", err.Error(), filter)
+		}
+	}
+
+	if validator.HasErrors() {
+		response.ValidationError(c, validator.ErrorsMap())
+		return "", false
+	}
+
+	return filter, true
+}
+
+// parseRanges validates the optional range parameters of the given fields: ?<field>Min= and
+// ?<field>Max= for numeric fields, ?<field>From= and ?<field>To= for date fields, plus
+// ?includeUnknown=. They are read directly from the query string since only people and
+// planets support them. On failure the error response is already sent.
+func parseRanges(c *gin.Context, numberFields, dateFields []string) (domain.RangeFilter, bool) {
+	validator := validation.New()
+	var ranges domain.RangeFilter
+
+	for _, field := range numberFields {
+		minField, maxField := field+"Min", field+"Max"
+		min, max := validator.ValidateNumberRange(minField, strings.TrimSpace(c.Query(minField)), maxField, strings.TrimSpace(c.Query(maxField)))
+		if min != nil || max != nil {
+			ranges.Numbers = append(ranges.Numbers, domain.NumberRange{Field: field, Min: min, Max: max})
+		}
+	}
+
+	for _, field := range dateFields {
+		fromField, toField := field+"From", field+"To"
+		from, to := validator.ValidateDateRange(fromField, strings.TrimSpace(c.Query(fromField)), toField, strings.TrimSpace(c.Query(toField)))
+		if from != nil || to != nil {
+			ranges.Dates = append(ranges.Dates, domain.DateRange{Field: field, From: from, To: to})
+		}
+	}
+
+	includeUnknown := strings.TrimSpace(c.Query("includeUnknown"))
+	validator.ValidateOneOf("includeUnknown", includeUnknown, allowedIncludeUnknown)
+	ranges.IncludeUnknown = includeUnknown == "true"
+
+	if validator.HasErrors() {
+		response.ValidationError(c, validator.ErrorsMap())
+		return domain.RangeFilter{}, false
+	}
+
+	return ranges, true
+}
+
+// parseListMode validates the optional ?mode= parameter.
+// On failure the error response is already sent.
+func parseListMode(c *gin.Context) (domain.ListMode, bool) {
+	mode := middleware.GetQueryParams(c).Mode
+
+	validator := validation.New()
+	validator.ValidateOneOf("mode", mode, allowedListMode)
+
+	if validator.HasErrors() {
+		response.ValidationError(c, validator.ErrorsMap())
+		return "", false
+	}
+
+	return domain.ListMode(mode), true
+}
+
+// ListQuery converts the validated parameters into the service-level list query.
+func (p ListQueryParams) ListQuery() domain.ListQuery {
+	return domain.ListQuery{
+		Page:     p.Page,
+		PageSize: p.PageSize,
+		Search:   p.Search,
+		Sort:     p.Sort,
+	}
+}
+
+// Apply copies the match options onto a list query.
+func (m SearchMatch) Apply(q *domain.ListQuery) {
+	q.Match = m.Mode
+	q.Threshold = m.Threshold
+	q.SearchIn = m.Fields
+}
+
+// ListQuery converts the validated people parameters into the service-level list query.
+func (p PeopleQueryParams) ListQuery() domain.ListQuery {
+	q := p.ListQueryParams.ListQuery()
+	p.Match.Apply(&q)
+	q.Filter = p.Filter
+	q.Ranges = p.Ranges
+	q.Expand = p.Expand
+	q.Mode = p.Mode
+	return q
+}
+
+// ListQuery converts the validated planet parameters into the service-level list query.
+func (p PlanetQueryParams) ListQuery() domain.ListQuery {
+	q := p.ListQueryParams.ListQuery()
+	p.Match.Apply(&q)
+	q.Filter = p.Filter
+	q.Ranges = p.Ranges
+	q.Expand = p.Expand
+	q.Mode = p.Mode
+	return q
+}
+
+// SuggestQueryParams holds the validated query parameters for the suggest endpoint.
+type SuggestQueryParams struct {
+	Query string   // Prefix to complete (required)
+	Types []string // Resource types to suggest; empty means all (optional)
+	Limit int      // Maximum suggestions, 1-50 (default: 10)
+}
+
+// ParseSuggestQueryParams validates the ?q=, ?type= and ?limit= parameters of the suggest endpoint.
+// They are read directly from the query string since no list endpoint shares them.
+// On failure the error response is already sent.
+func ParseSuggestQueryParams(c *gin.Context) (SuggestQueryParams, bool) {
+	query := strings.TrimSpace(c.Query("q"))
+	types := middleware.SplitList(c.Query("type"))
+	rawLimit := strings.TrimSpace(c.Query("limit"))
+
+	validator := validation.New()
+	validator.ValidateNotEmpty("q", query)
+	for _, typ := range types {
+		validator.ValidateOneOf("type", typ, allowedSuggestTypes)
+	}
+
+	limit := defaultSuggestLimit
+	if rawLimit != "" && validator.ValidatePositiveInt("limit", rawLimit) {
+		limit, _ = strconv.Atoi(rawLimit) // Already validated above
+		if limit > maxSuggestLimit {
+			validator.AddError("limit", fmt.Sprintf("must be at most %d", maxSuggestLimit), rawLimit)
+		}
+	}
+
+	if validator.HasErrors() {
+		response.ValidationError(c, validator.ErrorsMap())
+		return SuggestQueryParams{}, false
+	}
+
+	return SuggestQueryParams{Query: query, Types: types, Limit: limit}, true
+}
+
+// ParseResourceID reads the ":id" path parameter and validates it is a positive integer.
+// Invalid IDs are rejected here so they never reach SWAPI.
+//
+// Returns:
+//   - string: the validated ID
+//   - bool: true if valid, false if validation failed (error already sent to client)
+func ParseResourceID(c *gin.Context) (string, bool) {
+	id := c.Param("id")
+
+	validator := validation.New()
+	validator.ValidatePositiveInt("id", id)
+
+	if validator.HasErrors() {
+		response.ValidationError(c, validator.ErrorsMap())
+		return "", false
+	}
+
+	return id, true
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
+	"github.com/stressedbypull/swapi-connector/internal/ports"
+)
+
+// SpeciesHandler handles HTTP requests for species resources.
+type SpeciesHandler struct {
+	service ports.SpeciesServiceInterface
+}
+
+// NewSpeciesHandler creates a new species handler with dependency injection.
+func NewSpeciesHandler(service ports.SpeciesServiceInterface) *SpeciesHandler {
+	return &SpeciesHandler{
+		service: service,
+	}
+}
+
+// ListSpecies godoc
+// @Summary      List Star Wars species
+// @Description  Get a paginated list of species from SWAPI with optional search, exact-match attribute filters and sorting
+// @Tags         species
+// @Accept       json
+// @Produce      json
+// @Param        page            query     int     false  "Page number"                     default(1)       example(1)
+// @Param        pageSize        query     int     false  "Items per page (1-100 by default, configurable)"  default(15)  example(25)
+// @Param        search          query     string  false  "Search by name"                  example(wook)
+// @Param        classification  query     string  false  "Exact classification (case-insensitive)"  example(mammal)
+// @Param        designation     query     string  false  "Exact designation (case-insensitive)"     example(sentient)
+// @Param        language        query     string  false  "Exact language (case-insensitive)"        example(Galactic Basic)
+// @Param        sort            query     string  false  "Multi-key sort (field:asc|desc, comma-separated); replaces sortBy/sortOrder"  example(averageLifespan:desc,name)
+// @Param        sortBy          query     string  false  "Sort field"  example(averageHeight)
+// @Param        sortOrder       query     string  false  "Sort order"                      Enums(asc, desc)            default(asc)  example(asc)
+// @Param        locale          query     string  false  "Collation locale for name sorting (BCP 47)"  default(en)  example(sv)
+// @Param        nulls           query     string  false  "Where unknown numeric values sort, whatever the direction"  Enums(first, last)  default(last)
+// @Success      200  {object}  SpeciesListResponse  "Successful response with species list"
+// @Failure      400  {object}  ErrorResponse        "Invalid request parameters"
+// @Failure      404  {object}  ErrorResponse        "Species not found"
+// @Failure      500  {object}  ErrorResponse        "Internal server error"
+// @Router       /species [get]
+func (h *SpeciesHandler) ListSpecies(c *gin.Context) {
+	// Parse and validate query parameters
+	params, ok := ParseSpeciesQueryParams(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	// Call service
+	result, err := h.service.ListSpecies(
+		c.Request.Context(),
+		params.Page,
+		params.PageSize,
+		params.Search,
+		params.Sort,
+		params.Filter,
+	)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	linkPages(c, &result, params.PageSize, cursorPage{})
+	response.OK(c, result)
+}
+
+// GetSpeciesByID godoc
+// @Summary      Get a Star Wars species
+// @Description  Get a single species from SWAPI by its numeric ID
+// @Tags         species
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Species ID"  example(3)
+// @Success      200  {object}  Species        "Successful response with species"
+// @Failure      400  {object}  ErrorResponse  "Invalid species ID"
+// @Failure      404  {object}  ErrorResponse  "Species not found"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Router       /species/{id} [get]
+func (h *SpeciesHandler) GetSpeciesByID(c *gin.Context) {
+	// Validate ID before calling upstream
+	id, ok := ParseResourceID(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	species, err := h.service.GetSpeciesByID(c.Request.Context(), id)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, species)
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stressedbypull/swapi-connector/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSpeciesHandler_ListSpecies - Unit test with mocks
+func TestSpeciesHandler_ListSpecies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	allSpecies := []domain.Species{
+		{Name: "Human", Classification: "mammal", Language: "Galactic Basic", AverageHeight: domain.Measure(180)},
+		{Name: "Wookie", Classification: "mammal", Language: "Shyriiwook", AverageHeight: domain.Measure(210)},
+		{Name: "Trandoshan", Classification: "reptile", Language: "Dosh", AverageHeight: domain.Measure(200)},
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		setupMock      func(m *mocks.MockSwapiRepository)
+		expectedStatus int
+		wantNames      []string
+		wantCount      int
+		wantCode       string
+	}{
+		{
+			name: "filter by classification and sort by height",
+			url:  "/species?classification=mammal&sortBy=averageHeight&sortOrder=desc",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchAllSpecies", mock.Anything).Return(allSpecies, nil)
+			},
+			expectedStatus: http.StatusOK,
+			wantNames:      []string{"Wookie", "Human"},
+			wantCount:      2,
+		},
+		{
+			name: "filters the whole collection before paginating",
+			url:  "/species?classification=reptile&pageSize=1",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchAllSpecies", mock.Anything).Return(allSpecies, nil)
+			},
+			expectedStatus: http.StatusOK,
+			wantNames:      []string{"Trandoshan"},
+			wantCount:      1,
+		},
+		{
+			name: "paginates the filtered species",
+			url:  "/species?classification=mammal&page=2&pageSize=1",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchAllSpecies", mock.Anything).Return(allSpecies, nil)
+			},
+			expectedStatus: http.StatusOK,
+			wantNames:      []string{"Wookie"},
+			wantCount:      2,
+		},
+		{
+			name: "no species match filters",
+			url:  "/species?language=huttese",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchAllSpecies", mock.Anything).Return(allSpecies, nil)
+			},
+			expectedStatus: http.StatusNotFound,
+			wantCode:       "SPECIES_NOT_FOUND",
+		},
+		{
+			name:           "invalid sort field",
+			url:            "/species?sortBy=mass",
+			expectedStatus: http.StatusBadRequest,
+			wantCode:       "VALIDATION_ERROR",
+		},
+		{
+			name:           "cursor pagination rejected",
+			url:            "/species?cursor=abc",
+			expectedStatus: http.StatusBadRequest,
+			wantCode:       "VALIDATION_ERROR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			handler := NewSpeciesHandler(services.NewSpeciesService(mockRepo, services.NewCollectionCache(time.Minute, mockRepo.FetchAllSpecies)))
+
+			router := gin.New()
+			router.Use(middleware.PaginationMiddleware(testPagination))
+			router.Use(middleware.QueryMiddleware())
+			router.GET("/species", handler.ListSpecies)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.wantCode != "" {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tt.wantCode, resp.Error.Code)
+			}
+
+			if tt.wantNames != nil {
+				var resp domain.PaginatedResponse[domain.Species]
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				names := make([]string, 0, len(resp.Results))
+				for _, s := range resp.Results {
+					names = append(names, s.Name)
+				}
+				assert.Equal(t, tt.wantNames, names)
+				assert.Equal(t, tt.wantCount, resp.Count)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
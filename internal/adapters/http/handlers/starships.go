@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
+	"github.com/stressedbypull/swapi-connector/internal/ports"
+)
+
+// StarshipHandler handles HTTP requests for starship resources.
+type StarshipHandler struct {
+	service ports.StarshipServiceInterface
+}
+
+// NewStarshipHandler creates a new starship handler with dependency injection.
+func NewStarshipHandler(service ports.StarshipServiceInterface) *StarshipHandler {
+	return &StarshipHandler{
+		service: service,
+	}
+}
+
+// ListStarships godoc
+// @Summary      List Star Wars starships
+// @Description  Get a paginated list of starships from SWAPI with optional search and sorting
+// @Tags         starships
+// @Accept       json
+// @Produce      json
+// @Param        page       query     int     false  "Page number"           default(1)       example(1)
+// @Param        pageSize   query     int     false  "Items per page (1-100 by default, configurable)"  default(15)  example(25)
+// @Param        search     query     string  false  "Search by name"        example(falcon)
+// @Param        sort       query     string  false  "Multi-key sort (field:asc|desc, comma-separated); replaces sortBy/sortOrder"  example(hyperdriveRating:desc,name)
+// @Param        sortBy     query     string  false  "Sort field"  example(hyperdriveRating)
+// @Param        sortOrder  query     string  false  "Sort order"            Enums(asc, desc)            default(asc)  example(asc)
+// @Param        locale     query     string  false  "Collation locale for name sorting (BCP 47)"  default(en)  example(sv)
+// @Param        nulls      query     string  false  "Where unknown numeric values sort, whatever the direction"  Enums(first, last)  default(last)
+// @Success      200  {object}  StarshipListResponse  "Successful response with starship list"
+// @Failure      400  {object}  ErrorResponse       "Invalid request parameters"
+// @Failure      404  {object}  ErrorResponse       "Starship not found"
+// @Failure      500  {object}  ErrorResponse       "Internal server error"
+// @Router       /starships [get]
+func (h *StarshipHandler) ListStarships(c *gin.Context) {
+	// Parse and validate query parameters
+	params, ok := ParseStarshipQueryParams(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	// Call service
+	result, err := h.service.ListStarships(
+		c.Request.Context(),
+		params.Page,
+		params.PageSize,
+		params.Search,
+		params.Sort,
+	)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	linkPages(c, &result, params.PageSize, cursorPage{})
+	response.OK(c, result)
+}
+
+// GetStarshipByID godoc
+// @Summary      Get a Star Wars starship
+// @Description  Get a single starship from SWAPI by its numeric ID
+// @Tags         starships
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Starship ID"  example(1)
+// @Success      200  {object}  Starship       "Successful response with starship"
+// @Failure      400  {object}  ErrorResponse  "Invalid starship ID"
+// @Failure      404  {object}  ErrorResponse  "Starship not found"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Router       /starships/{id} [get]
+func (h *StarshipHandler) GetStarshipByID(c *gin.Context) {
+	// Validate ID before calling upstream
+	id, ok := ParseResourceID(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	starship, err := h.service.GetStarshipByID(c.Request.Context(), id)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, starship)
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stressedbypull/swapi-connector/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStarshipHandler_ListStarships - Unit test with mocks
+func TestStarshipHandler_ListStarships(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		url            string
+		setupMock      func(m *mocks.MockSwapiRepository)
+		expectedStatus int
+		wantFirst      string
+	}{
+		{
+			name: "sort by hyperdriveRating",
+			url:  "/starships?sortBy=hyperdriveRating&sortOrder=asc",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				mockResp := domain.PaginatedResponse[domain.Starship]{
+					Count:   2,
+					Page:    1,
+					Results: []domain.Starship{{Name: "Star Destroyer", HyperdriveRating: domain.Measure(2)}, {Name: "Millennium Falcon", HyperdriveRating: domain.Measure(0.5)}},
+				}
+				m.On("FetchStarships", mock.Anything, 1, 15, "").Return(mockResp, nil)
+			},
+			expectedStatus: http.StatusOK,
+			wantFirst:      "Millennium Falcon",
+		},
+		{
+			name:           "invalid sort field",
+			url:            "/starships?sortBy=mass",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "cursor pagination rejected",
+			url:            "/starships?limit=2",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			handler := NewStarshipHandler(services.NewStarshipService(mockRepo))
+
+			router := gin.New()
+			router.Use(middleware.PaginationMiddleware(testPagination))
+			router.Use(middleware.QueryMiddleware())
+			router.GET("/starships", handler.ListStarships)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.wantFirst != "" {
+				var resp domain.PaginatedResponse[domain.Starship]
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				require.NotEmpty(t, resp.Results)
+				assert.Equal(t, tt.wantFirst, resp.Results[0].Name)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
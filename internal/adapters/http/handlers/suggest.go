@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/ports"
+)
+
+// SuggestHandler handles HTTP requests for name completions.
+type SuggestHandler struct {
+	service ports.SuggestServiceInterface
+}
+
+// NewSuggestHandler creates a new suggest handler with dependency injection.
+func NewSuggestHandler(service ports.SuggestServiceInterface) *SuggestHandler {
+	return &SuggestHandler{
+		service: service,
+	}
+}
+
+// suggestResponse is the body of a suggest response.
+type suggestResponse struct {
+	Results []domain.Suggestion `json:"results"`
+}
+
+// Suggest godoc
+// @Summary      Suggest names
+// @Description  Complete a name prefix across people, planets, films and species. Any word of a name can match ("sky" completes "Luke Skywalker"); names starting with the prefix rank first, then shorter names. Served from an in-memory index refreshed every SUGGEST_REFRESH_INTERVAL.
+// @Tags         suggest
+// @Accept       json
+// @Produce      json
+// @Param        q      query     string  true   "Name prefix"                            example(lu)
+// @Param        type   query     string  false  "Resource types (comma-separated)"       Enums(people, planets, films, species)  example(people,planets)
+// @Param        limit  query     int     false  "Maximum suggestions (1-50)"             default(10)  example(10)
+// @Success      200  {object}  SuggestResponse  "Ranked suggestions"
+// @Failure      400  {object}  ErrorResponse    "Invalid request parameters"
+// @Failure      500  {object}  ErrorResponse    "Internal server error"
+// @Router       /suggest [get]
+func (h *SuggestHandler) Suggest(c *gin.Context) {
+	// Parse and validate query parameters
+	params, ok := ParseSuggestQueryParams(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	suggestions, err := h.service.Suggest(c.Request.Context(), params.Query, params.Types, params.Limit)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, suggestResponse{Results: suggestions})
+}
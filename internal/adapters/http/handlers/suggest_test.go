@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stressedbypull/swapi-connector/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSuggestHandler_Suggest - Unit test with mocks
+func TestSuggestHandler_Suggest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	setupIndex := func(m *mocks.MockSwapiRepository) {
+		m.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{
+			{ID: "1", Name: "Luke Skywalker"},
+			{ID: "81", Name: "Lumiya"},
+		}, nil)
+		m.On("FetchAllPlanets", mock.Anything).Return([]domain.Planet{{ID: "61", Name: "Lutrillia"}}, nil)
+		m.On("FetchAllFilms", mock.Anything).Return([]domain.Film{{ID: "1", Title: "A New Hope"}}, nil)
+		m.On("FetchAllSpecies", mock.Anything).Return([]domain.Species{{ID: "1", Name: "Human"}}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		setupMock      func(m *mocks.MockSwapiRepository)
+		expectedStatus int
+		want           []domain.Suggestion
+		wantDetails    []string
+	}{
+		{
+			name:           "ranked suggestions across types",
+			url:            "/suggest?q=lu",
+			setupMock:      setupIndex,
+			expectedStatus: http.StatusOK,
+			want: []domain.Suggestion{
+				{Type: "people", ID: "81", Name: "Lumiya"},
+				{Type: "planets", ID: "61", Name: "Lutrillia"},
+				{Type: "people", ID: "1", Name: "Luke Skywalker"},
+			},
+		},
+		{
+			name:           "type and limit",
+			url:            "/suggest?q=lu&type=people&limit=1",
+			setupMock:      setupIndex,
+			expectedStatus: http.StatusOK,
+			want:           []domain.Suggestion{{Type: "people", ID: "81", Name: "Lumiya"}},
+		},
+		{
+			name:           "no match returns an empty list",
+			url:            "/suggest?q=xyz",
+			setupMock:      setupIndex,
+			expectedStatus: http.StatusOK,
+			want:           []domain.Suggestion{},
+		},
+		{
+			name:           "invalid parameters",
+			url:            "/suggest?type=starships&limit=500",
+			expectedStatus: http.StatusBadRequest,
+			wantDetails:    []string{"q", "type", "limit"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			handler := NewSuggestHandler(services.NewSuggestService(
+				services.NewCollectionCache(0, mockRepo.APIRetrieveAllPeople),
+				services.NewCollectionCache(0, mockRepo.FetchAllPlanets),
+				services.NewCollectionCache(0, mockRepo.FetchAllFilms),
+				services.NewCollectionCache(0, mockRepo.FetchAllSpecies),
+				time.Hour,
+			))
+
+			router := gin.New()
+			router.GET("/suggest", handler.Suggest)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.wantDetails != nil {
+				var resp response.ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, "VALIDATION_ERROR", resp.Error.Code)
+				for _, field := range tt.wantDetails {
+					assert.Contains(t, resp.Error.Details, field)
+				}
+			}
+
+			if tt.want != nil {
+				var resp struct {
+					Results []domain.Suggestion `json:"results"`
+				}
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tt.want, resp.Results)
+			}
+		})
+	}
+}
@@ -1,25 +1,306 @@
 package handlers
 
-import "time"
-
 // Person represents a Star Wars character.
 //
-// @Description Star Wars character information
+// @Description Star Wars character information. Height and mass are null when unknown.
+// @Description Descriptive attributes use "unknown" when SWAPI has no data and "n/a" when they do not apply.
 type Person struct {
-	Name    string    `json:"name" example:"Luke Skywalker"`
-	Mass    int       `json:"mass" example:"77"`
-	Created time.Time `json:"created" example:"2014-12-09T13:50:51.644000Z"`
-	Films   []string  `json:"films" example:"https://swapi.dev/api/films/1/,https://swapi.dev/api/films/2/"`
+	// ID is the value to pass to the detail endpoint
+	ID        string   `json:"id" example:"1"`
+	Name      string   `json:"name" example:"Luke Skywalker"`
+	Height    *float64 `json:"height" extensions:"x-nullable" example:"172"`
+	Mass      *float64 `json:"mass" extensions:"x-nullable" example:"78.2"`
+	HairColor string   `json:"hairColor" example:"blond"`
+	SkinColor string   `json:"skinColor" example:"fair"`
+	EyeColor  string   `json:"eyeColor" example:"blue"`
+	BirthYear string   `json:"birthYear" example:"19BBY"`
+	Gender    string   `json:"gender" example:"male"`
+	Created   string   `json:"created" example:"2014-12-09"`
+	Edited    string   `json:"edited" example:"2014-12-20"`
+	Films     []string `json:"films" example:"https://swapi.dev/api/films/1/,https://swapi.dev/api/films/2/"`
+	FilmIDs   []string `json:"filmIds" example:"1,2"`
+	// Homeworld is the SWAPI URL of the character's home planet
+	Homeworld   string   `json:"homeworld" example:"https://swapi.dev/api/planets/1/"`
+	HomeworldID string   `json:"homeworldId" example:"1"`
+	Species     []string `json:"species" example:"https://swapi.dev/api/species/2/"`
+	SpeciesIDs  []string `json:"speciesIds" example:"2"`
+	Vehicles    []string `json:"vehicles" example:"https://swapi.dev/api/vehicles/14/"`
+	VehicleIDs  []string `json:"vehicleIds" example:"14"`
+	Starships   []string `json:"starships" example:"https://swapi.dev/api/starships/12/"`
+	StarshipIDs []string `json:"starshipIds" example:"12"`
+	// Relevance is present only with ?match=fuzzy
+	Relevance float64 `json:"relevance,omitempty" example:"0.89"`
+	// Expanded is present only when ?expand= is requested
+	Expanded *PersonRelations `json:"expanded,omitempty"`
+}
+
+// PersonRelations holds related resources embedded via ?expand= on people.
+//
+// @Description Related resources resolved server-side (films, homeworld)
+type PersonRelations struct {
+	Films     []FilmSummary  `json:"films,omitempty"`
+	Homeworld *PlanetSummary `json:"homeworld,omitempty"`
 }
 
 // PeopleListResponse represents a paginated response of people.
 //
 // @Description Paginated list of Star Wars characters
 type PeopleListResponse struct {
-	Count    int      `json:"count" example:"82"`
-	Page     int      `json:"page" example:"1"`
-	PageSize int      `json:"pageSize" example:"15"`
-	Results  []Person `json:"results"`
+	Count       int      `json:"count" example:"82"`
+	Page        int      `json:"page,omitempty" example:"1"` // Omitted with cursor pagination
+	PageSize    int      `json:"pageSize" example:"15"`
+	TotalPages  int      `json:"totalPages" example:"6"`
+	HasNext     bool     `json:"hasNext" example:"true"`
+	HasPrevious bool     `json:"hasPrevious" example:"false"`
+	Results     []Person `json:"results"`
+	NextCursor  string   `json:"nextCursor,omitempty"`                                                // With cursor pagination, when a next page exists
+	PrevCursor  string   `json:"prevCursor,omitempty"`                                                // With cursor pagination, when a previous page exists
+	First       string   `json:"first,omitempty" example:"https://api.example.com/api/people?page=1"` // Absolute URL of the first page
+	Previous    string   `json:"previous,omitempty"`                                                  // Absolute URL of the preceding page, if any
+	Next        string   `json:"next,omitempty" example:"https://api.example.com/api/people?page=2"`  // Absolute URL of the following page, if any
+	Last        string   `json:"last,omitempty"`                                                      // Absolute URL of the last page
+}
+
+// Planet represents a Star Wars planet.
+//
+// @Description Star Wars planet information. Unknown numeric attributes are null.
+type Planet struct {
+	// ID is the value to pass to the detail endpoint
+	ID             string   `json:"id" example:"1"`
+	Name           string   `json:"name" example:"Tatooine"`
+	RotationPeriod *float64 `json:"rotationPeriod" extensions:"x-nullable" example:"23"`
+	OrbitalPeriod  *float64 `json:"orbitalPeriod" extensions:"x-nullable" example:"304"`
+	Diameter       *float64 `json:"diameter" extensions:"x-nullable" example:"10465"`
+	Gravity        string   `json:"gravity" example:"1 standard"`
+	Population     *float64 `json:"population" extensions:"x-nullable" example:"200000"`
+	SurfaceWater   *float64 `json:"surfaceWater" extensions:"x-nullable" example:"1"`
+	Climate        []string `json:"climate" example:"arid"`
+	Terrain        []string `json:"terrain" example:"desert"`
+	Residents      []string `json:"residents" example:"https://swapi.dev/api/people/1/,https://swapi.dev/api/people/2/"`
+	ResidentIDs    []string `json:"residentIds" example:"1,2"`
+	Created        string   `json:"created" example:"2014-12-09"`
+	Films          []string `json:"films" example:"https://swapi.dev/api/films/1/,https://swapi.dev/api/films/3/"`
+	FilmIDs        []string `json:"filmIds" example:"1,3"`
+	// Relevance is present only with ?match=fuzzy
+	Relevance float64 `json:"relevance,omitempty" example:"0.8"`
+	// Expanded is present only when ?expand= is requested
+	Expanded *PlanetRelations `json:"expanded,omitempty"`
+}
+
+// PlanetRelations holds related resources embedded via ?expand= on planets.
+//
+// @Description Related resources resolved server-side (residents, films)
+type PlanetRelations struct {
+	Residents []PersonSummary `json:"residents,omitempty"`
+	Films     []FilmSummary   `json:"films,omitempty"`
+}
+
+// PersonSummary is a lightweight view of a related person.
+//
+// @Description Embedded person summary
+type PersonSummary struct {
+	URL  string `json:"url" example:"https://swapi.dev/api/people/1/"`
+	Name string `json:"name" example:"Luke Skywalker"`
+}
+
+// PlanetSummary is a lightweight view of a related planet.
+//
+// @Description Embedded planet summary
+type PlanetSummary struct {
+	URL  string `json:"url" example:"https://swapi.dev/api/planets/1/"`
+	Name string `json:"name" example:"Tatooine"`
+}
+
+// FilmSummary is a lightweight view of a related film.
+//
+// @Description Embedded film summary
+type FilmSummary struct {
+	URL         string `json:"url" example:"https://swapi.dev/api/films/1/"`
+	Title       string `json:"title" example:"A New Hope"`
+	EpisodeID   int    `json:"episodeId" example:"4"`
+	ReleaseDate string `json:"releaseDate" example:"1977-05-25"`
+}
+
+// PlanetListResponse represents a paginated response of planets.
+//
+// @Description Paginated list of Star Wars planets
+type PlanetListResponse struct {
+	Count       int      `json:"count" example:"60"`
+	Page        int      `json:"page,omitempty" example:"1"` // Omitted with cursor pagination
+	PageSize    int      `json:"pageSize" example:"15"`
+	TotalPages  int      `json:"totalPages" example:"4"`
+	HasNext     bool     `json:"hasNext" example:"true"`
+	HasPrevious bool     `json:"hasPrevious" example:"false"`
+	Results     []Planet `json:"results"`
+	NextCursor  string   `json:"nextCursor,omitempty"`                                                 // With cursor pagination, when a next page exists
+	PrevCursor  string   `json:"prevCursor,omitempty"`                                                 // With cursor pagination, when a previous page exists
+	First       string   `json:"first,omitempty" example:"https://api.example.com/api/planets?page=1"` // Absolute URL of the first page
+	Previous    string   `json:"previous,omitempty"`                                                   // Absolute URL of the preceding page, if any
+	Next        string   `json:"next,omitempty" example:"https://api.example.com/api/planets?page=2"`  // Absolute URL of the following page, if any
+	Last        string   `json:"last,omitempty"`                                                       // Absolute URL of the last page
+}
+
+// Film represents a Star Wars film.
+//
+// @Description Star Wars film information
+type Film struct {
+	// ID is the value to pass to the detail endpoint
+	ID           string   `json:"id" example:"1"`
+	Title        string   `json:"title" example:"A New Hope"`
+	EpisodeID    int      `json:"episodeId" example:"4"`
+	OpeningCrawl string   `json:"openingCrawl" example:"It is a period of civil war..."`
+	Director     string   `json:"director" example:"George Lucas"`
+	Producer     string   `json:"producer" example:"Gary Kurtz, Rick McCallum"`
+	ReleaseDate  string   `json:"releaseDate" example:"1977-05-25"`
+	Characters   []string `json:"characters" example:"https://swapi.dev/api/people/1/,https://swapi.dev/api/people/2/"`
+	CharacterIDs []string `json:"characterIds" example:"1,2"`
+	Planets      []string `json:"planets" example:"https://swapi.dev/api/planets/1/,https://swapi.dev/api/planets/2/"`
+	PlanetIDs    []string `json:"planetIds" example:"1,2"`
+	Created      string   `json:"created" example:"2014-12-10"`
+}
+
+// FilmListResponse represents a paginated response of films.
+//
+// @Description Paginated list of Star Wars films
+type FilmListResponse struct {
+	Count       int    `json:"count" example:"6"`
+	Page        int    `json:"page,omitempty" example:"1"` // Omitted with cursor pagination
+	PageSize    int    `json:"pageSize" example:"6"`
+	TotalPages  int    `json:"totalPages" example:"1"`
+	HasNext     bool   `json:"hasNext" example:"false"`
+	HasPrevious bool   `json:"hasPrevious" example:"false"`
+	Results     []Film `json:"results"`
+	NextCursor  string `json:"nextCursor,omitempty"`                                               // With cursor pagination, when a next page exists
+	PrevCursor  string `json:"prevCursor,omitempty"`                                               // With cursor pagination, when a previous page exists
+	First       string `json:"first,omitempty" example:"https://api.example.com/api/films?page=1"` // Absolute URL of the first page
+	Previous    string `json:"previous,omitempty"`                                                 // Absolute URL of the preceding page, if any
+	Next        string `json:"next,omitempty"`                                                     // Absolute URL of the following page, if any
+	Last        string `json:"last,omitempty"`                                                     // Absolute URL of the last page
+}
+
+// Starship represents a Star Wars starship.
+//
+// @Description Star Wars starship information. Unknown numeric specs are null.
+type Starship struct {
+	// ID is the value to pass to the detail endpoint
+	ID                   string   `json:"id" example:"1"`
+	Name                 string   `json:"name" example:"Millennium Falcon"`
+	Model                string   `json:"model" example:"YT-1300 light freighter"`
+	Manufacturer         string   `json:"manufacturer" example:"Corellian Engineering Corporation"`
+	CostInCredits        *float64 `json:"costInCredits" extensions:"x-nullable" example:"100000"`
+	Length               *float64 `json:"length" extensions:"x-nullable" example:"34.37"`
+	MaxAtmospheringSpeed *float64 `json:"maxAtmospheringSpeed" extensions:"x-nullable" example:"1050"`
+	Crew                 string   `json:"crew" example:"4"`
+	Passengers           string   `json:"passengers" example:"6"`
+	CargoCapacity        *float64 `json:"cargoCapacity" extensions:"x-nullable" example:"100000"`
+	Consumables          string   `json:"consumables" example:"2 months"`
+	HyperdriveRating     *float64 `json:"hyperdriveRating" extensions:"x-nullable" example:"0.5"`
+	MGLT                 *float64 `json:"mglt" extensions:"x-nullable" example:"75"`
+	StarshipClass        string   `json:"starshipClass" example:"Light freighter"`
+	Pilots               []string `json:"pilots" example:"https://swapi.dev/api/people/13/,https://swapi.dev/api/people/14/"`
+	PilotIDs             []string `json:"pilotIds" example:"13"`
+	Films                []string `json:"films" example:"https://swapi.dev/api/films/1/,https://swapi.dev/api/films/2/"`
+	FilmIDs              []string `json:"filmIds" example:"1"`
+	Created              string   `json:"created" example:"2014-12-10"`
+}
+
+// StarshipListResponse represents a paginated response of starships.
+//
+// @Description Paginated list of Star Wars starships
+type StarshipListResponse struct {
+	Count       int        `json:"count" example:"36"`
+	Page        int        `json:"page" example:"1"`
+	PageSize    int        `json:"pageSize" example:"15"`
+	TotalPages  int        `json:"totalPages" example:"3"`
+	HasNext     bool       `json:"hasNext" example:"true"`
+	HasPrevious bool       `json:"hasPrevious" example:"false"`
+	Results     []Starship `json:"results"`
+	First       string     `json:"first,omitempty" example:"https://api.example.com/api/starships?page=1"` // Absolute URL of the first page
+	Previous    string     `json:"previous,omitempty"`                                                     // Absolute URL of the preceding page, if any
+	Next        string     `json:"next,omitempty" example:"https://api.example.com/api/starships?page=2"`  // Absolute URL of the following page, if any
+	Last        string     `json:"last,omitempty"`                                                         // Absolute URL of the last page
+}
+
+// Vehicle represents a Star Wars vehicle.
+//
+// @Description Star Wars vehicle information. Unknown numeric specs are null.
+type Vehicle struct {
+	// ID is the value to pass to the detail endpoint
+	ID                   string   `json:"id" example:"1"`
+	Name                 string   `json:"name" example:"Sand Crawler"`
+	Model                string   `json:"model" example:"Digger Crawler"`
+	Manufacturer         string   `json:"manufacturer" example:"Corellia Mining Corporation"`
+	CostInCredits        *float64 `json:"costInCredits" extensions:"x-nullable" example:"150000"`
+	Length               *float64 `json:"length" extensions:"x-nullable" example:"36.8"`
+	MaxAtmospheringSpeed *float64 `json:"maxAtmospheringSpeed" extensions:"x-nullable" example:"30"`
+	Crew                 string   `json:"crew" example:"46"`
+	Passengers           string   `json:"passengers" example:"30"`
+	CargoCapacity        *float64 `json:"cargoCapacity" extensions:"x-nullable" example:"50000"`
+	Consumables          string   `json:"consumables" example:"2 months"`
+	VehicleClass         string   `json:"vehicleClass" example:"wheeled"`
+	Pilots               []string `json:"pilots" example:"https://swapi.dev/api/people/1/"`
+	PilotIDs             []string `json:"pilotIds" example:"1"`
+	Films                []string `json:"films" example:"https://swapi.dev/api/films/1/,https://swapi.dev/api/films/5/"`
+	FilmIDs              []string `json:"filmIds" example:"1"`
+	Created              string   `json:"created" example:"2014-12-10"`
+}
+
+// VehicleListResponse represents a paginated response of vehicles.
+//
+// @Description Paginated list of Star Wars vehicles
+type VehicleListResponse struct {
+	Count       int       `json:"count" example:"39"`
+	Page        int       `json:"page" example:"1"`
+	PageSize    int       `json:"pageSize" example:"15"`
+	TotalPages  int       `json:"totalPages" example:"3"`
+	HasNext     bool      `json:"hasNext" example:"true"`
+	HasPrevious bool      `json:"hasPrevious" example:"false"`
+	Results     []Vehicle `json:"results"`
+	First       string    `json:"first,omitempty" example:"https://api.example.com/api/vehicles?page=1"` // Absolute URL of the first page
+	Previous    string    `json:"previous,omitempty"`                                                    // Absolute URL of the preceding page, if any
+	Next        string    `json:"next,omitempty" example:"https://api.example.com/api/vehicles?page=2"`  // Absolute URL of the following page, if any
+	Last        string    `json:"last,omitempty"`                                                        // Absolute URL of the last page
+}
+
+// Species represents a Star Wars species.
+//
+// @Description Star Wars species information. Unknown or indefinite averages are null.
+type Species struct {
+	// ID is the value to pass to the detail endpoint
+	ID              string   `json:"id" example:"1"`
+	Name            string   `json:"name" example:"Wookie"`
+	Classification  string   `json:"classification" example:"mammal"`
+	Designation     string   `json:"designation" example:"sentient"`
+	AverageHeight   *float64 `json:"averageHeight" extensions:"x-nullable" example:"210"`
+	AverageLifespan *float64 `json:"averageLifespan" extensions:"x-nullable" example:"400"`
+	SkinColors      string   `json:"skinColors" example:"gray"`
+	HairColors      string   `json:"hairColors" example:"black, brown"`
+	EyeColors       string   `json:"eyeColors" example:"blue, green, yellow, brown, golden, red"`
+	Homeworld       string   `json:"homeworld" example:"https://swapi.dev/api/planets/14/"`
+	HomeworldID     string   `json:"homeworldId" example:"14"`
+	Language        string   `json:"language" example:"Shyriiwook"`
+	People          []string `json:"people" example:"https://swapi.dev/api/people/13/,https://swapi.dev/api/people/80/"`
+	PeopleIDs       []string `json:"peopleIds" example:"13"`
+	Films           []string `json:"films" example:"https://swapi.dev/api/films/1/,https://swapi.dev/api/films/2/"`
+	FilmIDs         []string `json:"filmIds" example:"1"`
+	Created         string   `json:"created" example:"2014-12-10"`
+}
+
+// SpeciesListResponse represents a paginated response of species.
+//
+// @Description Paginated list of Star Wars species
+type SpeciesListResponse struct {
+	Count       int       `json:"count" example:"37"`
+	Page        int       `json:"page" example:"1"`
+	PageSize    int       `json:"pageSize" example:"15"`
+	TotalPages  int       `json:"totalPages" example:"3"`
+	HasNext     bool      `json:"hasNext" example:"true"`
+	HasPrevious bool      `json:"hasPrevious" example:"false"`
+	Results     []Species `json:"results"`
+	First       string    `json:"first,omitempty" example:"https://api.example.com/api/species?page=1"` // Absolute URL of the first page
+	Previous    string    `json:"previous,omitempty"`                                                   // Absolute URL of the preceding page, if any
+	Next        string    `json:"next,omitempty" example:"https://api.example.com/api/species?page=2"`  // Absolute URL of the following page, if any
+	Last        string    `json:"last,omitempty"`                                                       // Absolute URL of the last page
 }
 
 // ErrorDetail contains error information.
@@ -37,3 +318,19 @@ type ErrorDetail struct {
 type ErrorResponse struct {
 	Error ErrorDetail `json:"error"`
 }
+
+// Suggestion represents a name completion.
+//
+// @Description A name completion; link to /api/{type}/{id}
+type Suggestion struct {
+	Type string `json:"type" example:"people"`
+	ID   string `json:"id" example:"1"`
+	Name string `json:"name" example:"Luke Skywalker"`
+}
+
+// SuggestResponse represents the response of the suggest endpoint.
+//
+// @Description Ranked name completions
+type SuggestResponse struct {
+	Results []Suggestion `json:"results"`
+}
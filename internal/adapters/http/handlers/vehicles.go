@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/response"
+	"github.com/stressedbypull/swapi-connector/internal/ports"
+)
+
+// VehicleHandler handles HTTP requests for vehicle resources.
+type VehicleHandler struct {
+	service ports.VehicleServiceInterface
+}
+
+// NewVehicleHandler creates a new vehicle handler with dependency injection.
+func NewVehicleHandler(service ports.VehicleServiceInterface) *VehicleHandler {
+	return &VehicleHandler{
+		service: service,
+	}
+}
+
+// ListVehicles godoc
+// @Summary      List Star Wars vehicles
+// @Description  Get a paginated list of vehicles from SWAPI with optional search and sorting
+// @Tags         vehicles
+// @Accept       json
+// @Produce      json
+// @Param        page       query     int     false  "Page number"           default(1)       example(1)
+// @Param        pageSize   query     int     false  "Items per page (1-100 by default, configurable)"  default(15)  example(25)
+// @Param        search     query     string  false  "Search by name"        example(crawler)
+// @Param        sort       query     string  false  "Multi-key sort (field:asc|desc, comma-separated); replaces sortBy/sortOrder"  example(maxAtmospheringSpeed:desc,name)
+// @Param        sortBy     query     string  false  "Sort field"  example(costInCredits)
+// @Param        sortOrder  query     string  false  "Sort order"            Enums(asc, desc)            default(asc)  example(asc)
+// @Param        locale     query     string  false  "Collation locale for name sorting (BCP 47)"  default(en)  example(sv)
+// @Param        nulls      query     string  false  "Where unknown numeric values sort, whatever the direction"  Enums(first, last)  default(last)
+// @Success      200  {object}  VehicleListResponse   "Successful response with vehicle list"
+// @Failure      400  {object}  ErrorResponse       "Invalid request parameters"
+// @Failure      404  {object}  ErrorResponse       "Vehicle not found"
+// @Failure      500  {object}  ErrorResponse       "Internal server error"
+// @Router       /vehicles [get]
+func (h *VehicleHandler) ListVehicles(c *gin.Context) {
+	// Parse and validate query parameters
+	params, ok := ParseVehicleQueryParams(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	// Call service
+	result, err := h.service.ListVehicles(
+		c.Request.Context(),
+		params.Page,
+		params.PageSize,
+		params.Search,
+		params.Sort,
+	)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	linkPages(c, &result, params.PageSize, cursorPage{})
+	response.OK(c, result)
+}
+
+// GetVehicleByID godoc
+// @Summary      Get a Star Wars vehicle
+// @Description  Get a single vehicle from SWAPI by its numeric ID
+// @Tags         vehicles
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Vehicle ID"  example(1)
+// @Success      200  {object}  Vehicle        "Successful response with vehicle"
+// @Failure      400  {object}  ErrorResponse  "Invalid vehicle ID"
+// @Failure      404  {object}  ErrorResponse  "Vehicle not found"
+// @Failure      500  {object}  ErrorResponse  "Internal server error"
+// @Router       /vehicles/{id} [get]
+func (h *VehicleHandler) GetVehicleByID(c *gin.Context) {
+	// Validate ID before calling upstream
+	id, ok := ParseResourceID(c)
+	if !ok {
+		return // Validation error already sent
+	}
+
+	vehicle, err := h.service.GetVehicleByID(c.Request.Context(), id)
+	if err != nil {
+		response.HandleError(c, err)
+		return
+	}
+
+	response.OK(c, vehicle)
+}
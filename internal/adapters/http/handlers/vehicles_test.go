@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/adapters/http/middleware"
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stressedbypull/swapi-connector/internal/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVehicleHandler_ListVehicles - Unit test with mocks
+func TestVehicleHandler_ListVehicles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name           string
+		url            string
+		setupMock      func(m *mocks.MockSwapiRepository)
+		expectedStatus int
+		wantFirst      string
+	}{
+		{
+			name: "sort by costInCredits",
+			url:  "/vehicles?sortBy=costInCredits&sortOrder=desc",
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				mockResp := domain.PaginatedResponse[domain.Vehicle]{
+					Count:   2,
+					Page:    1,
+					Results: []domain.Vehicle{{Name: "Snowspeeder", CostInCredits: domain.Measurement{}}, {Name: "Sand Crawler", CostInCredits: domain.Measure(150000)}},
+				}
+				m.On("FetchVehicles", mock.Anything, 1, 15, "").Return(mockResp, nil)
+			},
+			expectedStatus: http.StatusOK,
+			wantFirst:      "Sand Crawler",
+		},
+		{
+			name:           "invalid sort field",
+			url:            "/vehicles?sortBy=hyperdriveRating",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "cursor pagination rejected",
+			url:            "/vehicles?limit=2",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			handler := NewVehicleHandler(services.NewVehicleService(mockRepo))
+
+			router := gin.New()
+			router.Use(middleware.PaginationMiddleware(testPagination))
+			router.Use(middleware.QueryMiddleware())
+			router.GET("/vehicles", handler.ListVehicles)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.wantFirst != "" {
+				var resp domain.PaginatedResponse[domain.Vehicle]
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				require.NotEmpty(t, resp.Results)
+				assert.Equal(t, tt.wantFirst, resp.Results[0].Name)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
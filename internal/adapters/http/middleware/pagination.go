@@ -1,35 +1,47 @@
 package middleware
 
 import (
-	"strconv"
-
 	"github.com/gin-gonic/gin"
+	"github.com/stressedbypull/swapi-connector/internal/pagination"
 )
 
-const (
-	DefaultPage = 1
-	MinPage     = 1
-)
+// DefaultPage is the page returned when the request has no ?page=.
+const DefaultPage = 1
 
-// PaginationParams holds parsed pagination parameters.
+// PaginationSettings holds the server-side pagination configuration shared by all list endpoints.
+type PaginationSettings struct {
+	DefaultPageSize int                     // Page size when the request has no ?pageSize=
+	MinPageSize     int                     // Smallest ?pageSize= or ?limit= accepted
+	MaxPageSize     int                     // Largest ?pageSize= or ?limit= accepted
+	Cursors         *pagination.CursorCodec // Verifies incoming and signs outgoing cursors
+
+	// TrustForwardedHeaders builds page links from X-Forwarded-Proto/X-Forwarded-Host,
+	// which is correct behind an ingress that sets them and wrong when clients can.
+	TrustForwardedHeaders bool
+}
+
+// PaginationParams holds the raw pagination parameters of a request.
+// Example: ?page=2&pageSize=25, or ?limit=10 followed by ?cursor=<nextCursor>
 type PaginationParams struct {
-	Page int
+	Page     string // Optional: page number, raw (validated later)
+	PageSize string // Optional: items per page, raw (validated later against the settings)
+	Cursor   string // Optional: opaque cursor token from a previous response
+	Limit    string // Optional: items per cursor-paginated page, raw (validated later)
+	Settings PaginationSettings
 }
 
-// PaginationMiddleware extracts and validates page parameter from query string.
-// Sets it in the context for handlers to use.
-func PaginationMiddleware() gin.HandlerFunc {
+// PaginationMiddleware extracts the page, pageSize, cursor and limit parameters from the query string.
+// Like QueryMiddleware it does NOT validate them, so invalid values are rejected by the
+// handler instead of silently falling back to page 1. The settings travel with the
+// parameters, so handlers can validate sizes and cursors without further wiring.
+func PaginationMiddleware(settings PaginationSettings) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		page := DefaultPage
-
-		if pageStr := c.Query("page"); pageStr != "" {
-			if parsedPage, err := strconv.Atoi(pageStr); err == nil && parsedPage >= MinPage {
-				page = parsedPage
-			}
-		}
-
 		c.Set("pagination", PaginationParams{
-			Page: page,
+			Page:     c.Query("page"),
+			PageSize: c.Query("pageSize"),
+			Cursor:   c.Query("cursor"),
+			Limit:    c.Query("limit"),
+			Settings: settings,
 		})
 
 		c.Next()
@@ -40,14 +52,14 @@ func PaginationMiddleware() gin.HandlerFunc {
 func GetPaginationParams(c *gin.Context) PaginationParams {
 	value, exists := c.Get("pagination")
 	if !exists {
-		return PaginationParams{Page: DefaultPage}
+		return PaginationParams{}
 	}
 
 	// Safe type assertion with check
 	params, ok := value.(PaginationParams)
 	if !ok {
 		// Defensive: return default if type assertion fails
-		return PaginationParams{Page: DefaultPage}
+		return PaginationParams{}
 	}
 
 	return params
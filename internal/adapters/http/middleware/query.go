@@ -1,15 +1,26 @@
 package middleware
 
 import (
+	"strings"
+
 	"github.com/gin-gonic/gin"
 )
 
 // QueryParams holds search and sorting parameters extracted from URL query string.
-// Example: ?search=luke&sortBy=name&sortOrder=asc
+// Example: ?search=luke&sortBy=name&sortOrder=asc&expand=films
 type QueryParams struct {
-	Search    string // Optional: filter by name (e.g., "sky")
-	SortBy    string // Optional: field to sort by (e.g., "name", "created")
-	SortOrder string // Optional: "asc" or "desc" (default: "asc")
+	Search    string   // Optional: filter by name (e.g., "sky")
+	Match     string   // Optional: "contains" or "fuzzy" (validated later per resource)
+	Threshold string   // Optional: minimum fuzzy relevance, raw (validated later)
+	SearchIn  []string // Optional: fields the search term is matched against (e.g., "name,homeworld")
+	Filter    string   // Optional: filter expression (e.g., "mass>80 and gender=male")
+	Sort      []string // Optional: multi-key sort, raw "field:direction" items (e.g., "mass:desc,name")
+	SortBy    string   // Optional: field to sort by (e.g., "name", "created")
+	SortOrder string   // Optional: "asc" or "desc" (default: "asc")
+	Nulls     string   // Optional: "first" or "last", where unknown values sort (validated later)
+	Locale    string   // Optional: collation locale for name sorting, e.g. "sv" (validated later)
+	Expand    []string // Optional: relations to embed (e.g., "films,homeworld")
+	Mode      string   // Optional: "page" or "collection" (validated later per resource)
 }
 
 // QueryMiddleware extracts search and sort parameters from the URL query string.
@@ -17,16 +28,33 @@ type QueryParams struct {
 //
 // This middleware just extracts the raw values:
 //   - search: whatever the user typed
+//   - match/threshold: search matching mode and fuzzy strictness, raw
+//   - searchIn: comma-separated search fields, split and trimmed
+//   - filter: the raw filter expression (parsed and validated per resource)
+//   - sort: comma-separated "field:direction" keys, split and trimmed
 //   - sortBy: field name (validated later per resource)
 //   - sortOrder: defaults to "asc" if not provided
+//   - nulls: placement of unknown values, empty when not provided
+//   - locale: collation locale, empty when not provided
+//   - expand: comma-separated relation names, split and trimmed
+//   - mode: list mode, empty when not provided
 //
 // Example URL: /api/people?search=luke&sortBy=name&sortOrder=desc
 func QueryMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Extract query parameters from URL
-		search := c.Query("search")       // Get "search" param (empty string if not present)
+		search := c.Query("search") // Get "search" param (empty string if not present)
+		match := c.Query("match")
+		threshold := c.Query("threshold")
+		searchIn := SplitList(c.Query("searchIn"))
+		filter := c.Query("filter") // Get "filter" param (empty string if not present)
+		sort := SplitList(c.Query("sort"))
 		sortBy := c.Query("sortBy")       // Get "sortBy" param (empty string if not present)
 		sortOrder := c.Query("sortOrder") // Get "sortOrder" param (empty string if not present)
+		nulls := c.Query("nulls")
+		locale := c.Query("locale")
+		expand := SplitList(c.Query("expand"))
+		mode := c.Query("mode")
 
 		// Set default for sortOrder if empty
 		if sortOrder == "" {
@@ -36,8 +64,17 @@ func QueryMiddleware() gin.HandlerFunc {
 		// Store in context so handlers can access it
 		c.Set("queryParams", QueryParams{
 			Search:    search,
+			Match:     match,
+			Threshold: threshold,
+			SearchIn:  searchIn,
+			Filter:    filter,
+			Sort:      sort,
 			SortBy:    sortBy,
 			SortOrder: sortOrder,
+			Nulls:     nulls,
+			Locale:    locale,
+			Expand:    expand,
+			Mode:      mode,
 		})
 
 		c.Next() // Continue to next middleware/handler
@@ -62,3 +99,18 @@ func GetQueryParams(c *gin.Context) QueryParams {
 
 	return params
 }
+
+// SplitList splits a comma-separated query value, dropping empty items.
+func SplitList(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,71 @@
+// Package openapi adjusts the generated OpenAPI document before it is served.
+package openapi
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/swaggo/swag"
+)
+
+// sortEnumDoc serves a generated document with the sort enums filled in from the
+// sorter registries, so the docs always advertise exactly the fields the API accepts.
+type sortEnumDoc struct {
+	base          swag.Swagger
+	fieldsByRoute map[string][]string
+}
+
+// RegisterSortEnums registers base under name with the sortable fields of each route
+// (e.g. "/people" -> [name, created, mass]) set as the enum of its sortBy parameter
+// and listed in the description of its sort parameter.
+// Serve it with ginSwagger.InstanceName(name).
+func RegisterSortEnums(name string, base swag.Swagger, fieldsByRoute map[string][]string) {
+	swag.Register(name, sortEnumDoc{base: base, fieldsByRoute: fieldsByRoute})
+}
+
+// ReadDoc returns the patched document. If the base document cannot be
+// decoded it is returned unchanged.
+func (d sortEnumDoc) ReadDoc() string {
+	if d.base == nil {
+		return ""
+	}
+
+	doc := d.base.ReadDoc()
+	patched, err := withSortEnums(doc, d.fieldsByRoute)
+	if err != nil {
+		return doc
+	}
+	return patched
+}
+
+// withSortEnums sets the sort enums of every GET operation on the given routes.
+func withSortEnums(doc string, fieldsByRoute map[string][]string) (string, error) {
+	var spec map[string]any
+	if err := json.Unmarshal([]byte(doc), &spec); err != nil {
+		return "", err
+	}
+
+	paths, _ := spec["paths"].(map[string]any)
+	for route, fields := range fieldsByRoute {
+		item, _ := paths[route].(map[string]any)
+		operation, _ := item["get"].(map[string]any)
+		parameters, _ := operation["parameters"].([]any)
+
+		for _, p := range parameters {
+			param, _ := p.(map[string]any)
+			switch param["name"] {
+			case "sortBy":
+				param["enum"] = fields
+			case "sort":
+				description, _ := param["description"].(string)
+				param["description"] = strings.TrimSpace(description + " Fields: " + strings.Join(fields, ", "))
+			}
+		}
+	}
+
+	patched, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	return string(patched), nil
+}
@@ -0,0 +1,66 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticDoc is a generated document stub.
+type staticDoc string
+
+func (d staticDoc) ReadDoc() string { return string(d) }
+
+const generated = `{
+	"swagger": "2.0",
+	"paths": {
+		"/people": {
+			"get": {
+				"parameters": [
+					{"name": "page", "in": "query", "type": "integer"},
+					{"name": "sort", "in": "query", "type": "string", "description": "Multi-key sort"},
+					{"name": "sortBy", "in": "query", "type": "string", "enum": ["name"]}
+				]
+			}
+		},
+		"/people/{id}": {"get": {"parameters": [{"name": "id", "in": "path", "type": "integer"}]}}
+	}
+}`
+
+func TestSortEnumDoc_ReadDoc(t *testing.T) {
+	doc := sortEnumDoc{
+		base: staticDoc(generated),
+		fieldsByRoute: map[string][]string{
+			"/people":  {"name", "created", "mass"},
+			"/missing": {"name"},
+		},
+	}
+
+	var spec struct {
+		Paths map[string]struct {
+			Get struct {
+				Parameters []struct {
+					Name        string   `json:"name"`
+					Description string   `json:"description"`
+					Enum        []string `json:"enum"`
+				} `json:"parameters"`
+			} `json:"get"`
+		} `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(doc.ReadDoc()), &spec))
+
+	params := spec.Paths["/people"].Get.Parameters
+	require.Len(t, params, 3)
+	assert.Empty(t, params[0].Enum)
+	assert.Equal(t, "Multi-key sort Fields: name, created, mass", params[1].Description)
+	assert.Equal(t, []string{"name", "created", "mass"}, params[2].Enum)
+	assert.Len(t, spec.Paths["/people/{id}"].Get.Parameters, 1)
+}
+
+func TestSortEnumDoc_ReadDoc_Unparseable(t *testing.T) {
+	doc := sortEnumDoc{base: staticDoc("not json"), fieldsByRoute: map[string][]string{"/people": {"name"}}}
+
+	assert.Equal(t, "not json", doc.ReadDoc())
+}
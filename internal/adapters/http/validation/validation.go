@@ -2,10 +2,15 @@ package validation
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// DateLayout is the format accepted for date query parameters (same as the created field).
+const DateLayout = "2006-01-02"
+
 // Error represents a single validation error.
 type Error struct {
 	Field   string `json:"field"`
@@ -63,6 +68,85 @@ func (v *Validator) ValidatePositiveInt(field, value string) bool {
 	return true
 }
 
+// ValidateIntRange validates that a string is an integer within [min, max].
+func (v *Validator) ValidateIntRange(field, value string, min, max int) bool {
+	num, err := strconv.Atoi(value)
+	if err != nil || num < min || num > max {
+		v.AddError(field, fmt.Sprintf("must be an integer between %d and %d", min, max), value)
+		return false
+	}
+	return true
+}
+
+// ValidateFloatRange validates that a string is a number within (min, max].
+// Empty values are accepted as "not provided".
+func (v *Validator) ValidateFloatRange(field, value string, min, max float64) bool {
+	if value == "" {
+		return true // Optional field
+	}
+
+	num, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(num) || num <= min || num > max {
+		v.AddError(field, fmt.Sprintf("must be a number greater than %g and at most %g", min, max), value)
+		return false
+	}
+	return true
+}
+
+// ValidateNumberRange validates optional lower and upper bounds of a numeric range,
+// e.g. ?massMin=50&massMax=100. Each bound must be a number and min must not exceed max.
+// Returns the parsed bounds; a bound that is empty or invalid is nil.
+func (v *Validator) ValidateNumberRange(minField, minValue, maxField, maxValue string) (min, max *float64) {
+	min = v.parseNumberBound(minField, minValue)
+	max = v.parseNumberBound(maxField, maxValue)
+
+	if min != nil && max != nil && *min > *max {
+		v.AddError(minField, fmt.Sprintf("must not be greater than %s", maxField), minValue)
+		return nil, nil
+	}
+	return min, max
+}
+
+// ValidateDateRange validates optional start and end dates (YYYY-MM-DD) of a date range,
+// e.g. ?createdFrom=2014-12-09&createdTo=2014-12-20. From must not be after to.
+// Returns the parsed bounds; a bound that is empty or invalid is nil.
+func (v *Validator) ValidateDateRange(fromField, fromValue, toField, toValue string) (from, to *time.Time) {
+	from = v.parseDateBound(fromField, fromValue)
+	to = v.parseDateBound(toField, toValue)
+
+	if from != nil && to != nil && from.After(*to) {
+		v.AddError(fromField, fmt.Sprintf("must not be after %s", toField), fromValue)
+		return nil, nil
+	}
+	return from, to
+}
+
+func (v *Validator) parseNumberBound(field, value string) *float64 {
+	if value == "" {
+		return nil // Optional field
+	}
+
+	num, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(num) || math.IsInf(num, 0) {
+		v.AddError(field, "must be a number", value)
+		return nil
+	}
+	return &num
+}
+
+func (v *Validator) parseDateBound(field, value string) *time.Time {
+	if value == "" {
+		return nil // Optional field
+	}
+
+	date, err := time.Parse(DateLayout, value)
+	if err != nil {
+		v.AddError(field, "must be a date (YYYY-MM-DD)", value)
+		return nil
+	}
+	return &date
+}
+
 // ValidateNotEmpty validates that a string is not empty.
 func (v *Validator) ValidateNotEmpty(field, value string) bool {
 	trimmed := strings.TrimSpace(value)
@@ -89,26 +173,25 @@ func (v *Validator) ValidateOneOf(field, value string, allowed []string) bool {
 	return false
 }
 
-// ParseMass parses mass string to int, handling "unknown" and comma-separated values.
-// Returns 0 for invalid values instead of error.
-// Examples: "77" -> 77, "1,358" -> 1358, "unknown" -> 0
-func ParseMass(s string) int {
-	if s == "" || s == "unknown" {
-		return 0
+// ParseMeasurement parses SWAPI numeric strings, handling "unknown", "n/a", "none",
+// "indefinite", comma-separated values and a trailing "km" unit. Decimals are kept.
+// known is false when the value is missing or not a number; value is then 0.
+// Examples: "78.2" -> 78.2, "1,358" -> 1358, "1000km" -> 1000, "unknown" -> not known
+func ParseMeasurement(s string) (value float64, known bool) {
+	cleaned := strings.ToLower(strings.TrimSpace(s))
+	switch cleaned {
+	case "", "unknown", "n/a", "none", "indefinite":
+		return 0, false
 	}
 
-	// Remove commas: "1,358" -> "1358"
-	cleaned := strings.ReplaceAll(s, ",", "")
+	// Remove commas and units: "1,000km" -> "1000"
+	cleaned = strings.ReplaceAll(cleaned, ",", "")
+	cleaned = strings.TrimSuffix(cleaned, "km")
 
-	// Parse as integer
-	mass, err := strconv.Atoi(cleaned)
-	if err != nil {
-		// Try as float for values like "78.2"
-		if massFloat, floatErr := strconv.ParseFloat(cleaned, 64); floatErr == nil {
-			return int(massFloat)
-		}
-		return 0
+	value, err := strconv.ParseFloat(cleaned, 64)
+	if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, false
 	}
 
-	return mass
+	return value, true
 }
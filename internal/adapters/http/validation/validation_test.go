@@ -0,0 +1,139 @@
+package validation
+
+import "testing"
+
+func TestParseMeasurement(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      float64
+		wantKnown bool
+	}{
+		{name: "integer", input: "150000", want: 150000, wantKnown: true},
+		{name: "comma-formatted", input: "1,358", want: 1358, wantKnown: true},
+		{name: "decimal kept", input: "78.2", want: 78.2, wantKnown: true},
+		{name: "trailing km unit", input: "1000km", want: 1000, wantKnown: true},
+		{name: "large comma-formatted cost", input: "1,000,000,000,000", want: 1e12, wantKnown: true},
+		{name: "genuine zero", input: "0", want: 0, wantKnown: true},
+		{name: "unknown", input: "unknown", want: 0, wantKnown: false},
+		{name: "n/a", input: "n/a", want: 0, wantKnown: false},
+		{name: "none", input: "none", want: 0, wantKnown: false},
+		{name: "indefinite", input: "indefinite", want: 0, wantKnown: false},
+		{name: "mixed case and spaces", input: " Unknown ", want: 0, wantKnown: false},
+		{name: "empty", input: "", want: 0, wantKnown: false},
+		{name: "range is not a number", input: "30-165", want: 0, wantKnown: false},
+		{name: "NaN rejected", input: "NaN", want: 0, wantKnown: false},
+		{name: "Inf rejected", input: "Inf", want: 0, wantKnown: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, known := ParseMeasurement(tt.input)
+			if got != tt.want || known != tt.wantKnown {
+				t.Errorf("ParseMeasurement(%q) = (%v, %v), want (%v, %v)", tt.input, got, known, tt.want, tt.wantKnown)
+			}
+		})
+	}
+}
+
+func TestValidateNumberRange(t *testing.T) {
+	tests := []struct {
+		name             string
+		min, max         string
+		wantMin, wantMax float64 // -1 means nil
+		wantErr          bool
+	}{
+		{name: "both empty", wantMin: -1, wantMax: -1},
+		{name: "min only", min: "50", wantMin: 50, wantMax: -1},
+		{name: "max only", max: "100.5", wantMin: -1, wantMax: 100.5},
+		{name: "equal bounds", min: "80", max: "80", wantMin: 80, wantMax: 80},
+		{name: "not a number", min: "heavy", wantMin: -1, wantMax: -1, wantErr: true},
+		{name: "min greater than max", min: "100", max: "50", wantMin: -1, wantMax: -1, wantErr: true},
+		{name: "NaN rejected", max: "NaN", wantMin: -1, wantMax: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New()
+			min, max := v.ValidateNumberRange("massMin", tt.min, "massMax", tt.max)
+
+			if v.HasErrors() != tt.wantErr {
+				t.Errorf("HasErrors() = %v, want %v (%v)", v.HasErrors(), tt.wantErr, v.Errors())
+			}
+			if got := boundOrNil(min); got != tt.wantMin {
+				t.Errorf("min = %v, want %v", got, tt.wantMin)
+			}
+			if got := boundOrNil(max); got != tt.wantMax {
+				t.Errorf("max = %v, want %v", got, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestValidateIntRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{name: "lower bound", value: "1"},
+		{name: "upper bound", value: "100"},
+		{name: "below minimum", value: "0", wantErr: true},
+		{name: "above maximum", value: "101", wantErr: true},
+		{name: "not an integer", value: "2.5", wantErr: true},
+		{name: "empty", value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New()
+			ok := v.ValidateIntRange("pageSize", tt.value, 1, 100)
+
+			if ok == tt.wantErr || v.HasErrors() != tt.wantErr {
+				t.Errorf("ValidateIntRange(%q) = %v, errors %v; want error %v", tt.value, ok, v.Errors(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDateRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to string
+		wantFrom bool
+		wantTo   bool
+		wantErr  bool
+	}{
+		{name: "both empty"},
+		{name: "from only", from: "2014-12-09", wantFrom: true},
+		{name: "both set", from: "2014-12-09", to: "2014-12-20", wantFrom: true, wantTo: true},
+		{name: "same day", from: "2014-12-09", to: "2014-12-09", wantFrom: true, wantTo: true},
+		{name: "invalid date", to: "09/12/2014", wantErr: true},
+		{name: "from after to", from: "2014-12-20", to: "2014-12-09", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New()
+			from, to := v.ValidateDateRange("createdFrom", tt.from, "createdTo", tt.to)
+
+			if v.HasErrors() != tt.wantErr {
+				t.Errorf("HasErrors() = %v, want %v (%v)", v.HasErrors(), tt.wantErr, v.Errors())
+			}
+			if (from != nil) != tt.wantFrom {
+				t.Errorf("from = %v, want set=%v", from, tt.wantFrom)
+			}
+			if (to != nil) != tt.wantTo {
+				t.Errorf("to = %v, want set=%v", to, tt.wantTo)
+			}
+		})
+	}
+}
+
+// boundOrNil dereferences a parsed bound, reporting nil as -1.
+func boundOrNil(bound *float64) float64 {
+	if bound == nil {
+		return -1
+	}
+	return *bound
+}
@@ -2,164 +2,122 @@ package swapi
 
 import (
 	"context"
-	"encoding/json"
 	"net/http"
-	"os"
-	"strconv"
 	"time"
 
 	"github.com/stressedbypull/swapi-connector/internal/domain"
-	"github.com/stressedbypull/swapi-connector/internal/pagination"
 )
 
 const (
 	defaultTimeout  = 15 * time.Second
-	defaultPageSize = 15
+	defaultPageSize = 15 // Used when a list method is called with a non-positive pageSize
 )
 
-// Client implements ports.PeopleRepository and ports.PlanetsRepository.
+// Client implements the SWAPI repository ports (people, planets, films, starships, vehicles, species).
 // It fetches data from SWAPI, maps DTOs to domain objects.
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
-	pageSize   int // Desired page size for responses (SWAPI returns ~10 per page)
+	baseURL          string
+	httpClient       *http.Client
+	fetchConcurrency int           // Maximum SWAPI pages fetched in parallel for one aggregated page
+	pageSizes        pageSizeCache // Upstream page size per list endpoint, detected on first use
 }
 
 // NewClient creates a SWAPI client with dependency injection.
 // If httpClient is nil, a default client with 15s timeout is created.
-// Page size is read from SWAPI_PAGE_SIZE environment variable (default: 15).
-func NewClient(baseURL string, httpClient *http.Client) *Client {
+// Page sizes are chosen per request by the list methods' pageSize argument.
+// fetchConcurrency bounds the SWAPI pages fetched in parallel for one page (minimum 1).
+func NewClient(baseURL string, httpClient *http.Client, fetchConcurrency int) *Client {
 	if httpClient == nil {
 		httpClient = &http.Client{
 			Timeout: defaultTimeout,
 		}
 	}
-
-	// Read page size from environment variable
-	pageSize := defaultPageSize
-	if envPageSize := os.Getenv("SWAPI_PAGE_SIZE"); envPageSize != "" {
-		if size, err := strconv.Atoi(envPageSize); err == nil && size > 0 {
-			pageSize = size
-		}
+	if fetchConcurrency < 1 {
+		fetchConcurrency = 1
 	}
 
 	return &Client{
-		baseURL:    baseURL,
-		httpClient: httpClient,
-		pageSize:   pageSize,
+		baseURL:          baseURL,
+		httpClient:       httpClient,
+		fetchConcurrency: fetchConcurrency,
 	}
 }
 
 // APIRetrievePeople fetches people with pagination from SWAPI.
-// Aggregates SWAPI pages (~10 items each) to return the configured page size.
-func (c *Client) APIRetrievePeople(ctx context.Context, page int, search string) (domain.PaginatedResponse[domain.Person], error) {
-	const swapiPageSize = 10 // SWAPI returns ~10 items per page
-
-	// Fetch aggregated data from SWAPI
-	allPeople, totalCount, err := c.fetchAggregatedPeople(ctx, page, search, swapiPageSize)
-	if err != nil {
-		return domain.PaginatedResponse[domain.Person]{}, err
-	}
+// Aggregates SWAPI pages (of the detected upstream size) to return pageSize items.
+func (c *Client) APIRetrievePeople(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Person], error) {
+	return retrieveList(ctx, c, "people", page, pageSize, search, MapPeopleToDomain)
+}
 
-	// Use pagination package to build the response
-	strategy := pagination.NewAggregationStrategy(page, c.pageSize, swapiPageSize)
-	return pagination.BuildResponse(allPeople, totalCount, strategy), nil
+// APIRetrieveAllPeople fetches every person from SWAPI by walking all list pages.
+func (c *Client) APIRetrieveAllPeople(ctx context.Context) ([]domain.Person, error) {
+	return retrieveAll(ctx, c, "people", MapPeopleToDomain)
 }
 
 // APIRetrievePersonByID fetches a single person by ID from SWAPI.
 func (c *Client) APIRetrievePersonByID(ctx context.Context, id string) (domain.Person, error) {
-	url := c.baseURL + "/people/" + id + "/"
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return domain.Person{}, err
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return domain.Person{}, err
-	}
-	defer resp.Body.Close()
-
-	// Validate HTTP status code
-	if resp.StatusCode != http.StatusOK {
-		return domain.Person{}, handleHTTPError(resp.StatusCode, "person")
-	}
-
-	var personDTO PersonDTO
-	if err := json.NewDecoder(resp.Body).Decode(&personDTO); err != nil {
-		return domain.Person{}, err
-	}
-
-	person := MapPersonDTOToDomain(personDTO)
-	return person, nil
+	return retrieveResource(ctx, c, "people", id, "person", MapPersonDTOToDomain)
 }
 
-// fetchAggregatedPeople fetches multiple SWAPI pages and aggregates them.
-// This helper extracts the data-fetching logic from pagination logic.
-func (c *Client) fetchAggregatedPeople(ctx context.Context, page int, search string, swapiPageSize int) ([]domain.Person, int, error) {
-	// Create pagination strategy to determine which pages to fetch
-	strategy := pagination.NewAggregationStrategy(page, c.pageSize, swapiPageSize)
-	startPage, _, pagesNeeded := strategy.CalculatePageRange()
-
-	var allPeople []domain.Person
-	var totalCount int
-
-	// Fetch all necessary SWAPI pages
-	for i := 0; i < pagesNeeded; i++ {
-		currentPage := startPage + i
+// FetchPlanets fetches planets with pagination from SWAPI.
+// Aggregates SWAPI pages (of the detected upstream size) to return pageSize items.
+func (c *Client) FetchPlanets(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Planet], error) {
+	return retrieveList(ctx, c, "planets", page, pageSize, search, MapPlanetsToDomain)
+}
 
-		dto, err := c.fetchPeoplePage(ctx, currentPage, search)
-		if err != nil {
-			return nil, 0, err
-		}
+// FetchAllPlanets fetches every planet from SWAPI by walking all list pages.
+func (c *Client) FetchAllPlanets(ctx context.Context) ([]domain.Planet, error) {
+	return retrieveAll(ctx, c, "planets", MapPlanetsToDomain)
+}
 
-		// Store total count from first response
-		if i == 0 {
-			totalCount = dto.Count
-		}
+// FetchPlanetByID fetches a single planet by ID from SWAPI.
+func (c *Client) FetchPlanetByID(ctx context.Context, id string) (domain.Planet, error) {
+	return retrieveResource(ctx, c, "planets", id, "planet", MapPlanetDTOToDomain)
+}
 
-		// Map DTOs to domain objects
-		people := MapPeopleToDomain(dto.Results)
-		allPeople = append(allPeople, people...)
+// FetchFilms fetches films with pagination from SWAPI.
+func (c *Client) FetchFilms(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Film], error) {
+	return retrieveList(ctx, c, "films", page, pageSize, search, MapFilmsToDomain)
+}
 
-		// Stop if we got all available results (partial page)
-		if len(dto.Results) < swapiPageSize {
-			break
-		}
-	}
+// FetchAllFilms fetches every film from SWAPI by walking all list pages.
+func (c *Client) FetchAllFilms(ctx context.Context) ([]domain.Film, error) {
+	return retrieveAll(ctx, c, "films", MapFilmsToDomain)
+}
 
-	return allPeople, totalCount, nil
+// FetchFilmByID fetches a single film by ID from SWAPI.
+func (c *Client) FetchFilmByID(ctx context.Context, id string) (domain.Film, error) {
+	return retrieveResource(ctx, c, "films", id, "film", MapFilmDTOToDomain)
 }
 
-// fetchPeoplePage performs HTTP request to SWAPI people endpoint.
-func (c *Client) fetchPeoplePage(ctx context.Context, page int, search string) (*SWAPIPeopleResponse, error) {
-	url := BuildURL(c.baseURL, "people", page, search)
+// FetchStarships fetches starships with pagination from SWAPI.
+// Aggregates SWAPI pages (of the detected upstream size) to return pageSize items.
+func (c *Client) FetchStarships(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Starship], error) {
+	return retrieveList(ctx, c, "starships", page, pageSize, search, MapStarshipsToDomain)
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return nil, err
-	}
+// FetchStarshipByID fetches a single starship by ID from SWAPI.
+func (c *Client) FetchStarshipByID(ctx context.Context, id string) (domain.Starship, error) {
+	return retrieveResource(ctx, c, "starships", id, "starship", MapStarshipDTOToDomain)
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+// FetchVehicles fetches vehicles with pagination from SWAPI.
+// Aggregates SWAPI pages (of the detected upstream size) to return pageSize items.
+func (c *Client) FetchVehicles(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Vehicle], error) {
+	return retrieveList(ctx, c, "vehicles", page, pageSize, search, MapVehiclesToDomain)
+}
 
-	// Validate HTTP status code
-	if resp.StatusCode != http.StatusOK {
-		if resp.StatusCode == http.StatusNotFound {
-			// For people list endpoint, 404 might mean empty results
-			return &SWAPIPeopleResponse{Count: 0, Results: []PersonDTO{}}, nil
-		}
-		return nil, handleHTTPErrorForList(resp.StatusCode)
-	}
+// FetchVehicleByID fetches a single vehicle by ID from SWAPI.
+func (c *Client) FetchVehicleByID(ctx context.Context, id string) (domain.Vehicle, error) {
+	return retrieveResource(ctx, c, "vehicles", id, "vehicle", MapVehicleDTOToDomain)
+}
 
-	var response SWAPIPeopleResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, err
-	}
+// FetchAllSpecies fetches every species from SWAPI by walking all list pages.
+func (c *Client) FetchAllSpecies(ctx context.Context) ([]domain.Species, error) {
+	return retrieveAll(ctx, c, "species", MapSpeciesToDomain)
+}
 
-	return &response, nil
+// FetchSpeciesByID fetches a single species by ID from SWAPI.
+func (c *Client) FetchSpeciesByID(ctx context.Context, id string) (domain.Species, error) {
+	return retrieveResource(ctx, c, "species", id, "species", MapSpeciesDTOToDomain)
 }
@@ -1,12 +1,21 @@
 package swapi
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -43,14 +52,442 @@ func TestClient_ParsePeopleResponse(t *testing.T) {
 
 		// Verify domain mapping
 		assert.Equal(t, "Luke Skywalker", people[0].Name)
-		assert.Equal(t, 77, people[0].Mass)
+		assert.Equal(t, domain.Measure(77), people[0].Mass)
 		assert.NotEmpty(t, people[0].Create)
 		assert.Equal(t, "2014-12-09", people[0].Create)
 
 		assert.Equal(t, "Darth Vader", people[1].Name)
-		assert.Equal(t, 136, people[1].Mass)
+		assert.Equal(t, domain.Measure(136), people[1].Mass)
 
 		assert.Equal(t, "Leia Organa", people[2].Name)
-		assert.Equal(t, 49, people[2].Mass)
+		assert.Equal(t, domain.Measure(49), people[2].Mass)
+	})
+}
+
+func TestClient_ParsePlanetsResponse(t *testing.T) {
+	t.Run("Parse SWAPI planets response from fixture", func(t *testing.T) {
+		// Given: load fixture
+		mockData := loadTestFixture(t, "planets_response.json")
+
+		// When: parse the SWAPI response
+		var swapiResp SWAPIPlanetsResponse
+		err := json.Unmarshal(mockData, &swapiResp)
+		require.NoError(t, err)
+
+		// Then: verify structure and mapping
+		assert.Equal(t, 60, swapiResp.Count)
+		assert.NotNil(t, swapiResp.Next)
+		require.Len(t, swapiResp.Results, 2)
+
+		planets := MapPlanetsToDomain(swapiResp.Results)
+		assert.Equal(t, "Tatooine", planets[0].Name)
+		assert.Equal(t, "2014-12-09", planets[0].Created)
+		assert.Len(t, planets[0].Resident, 4)
+	})
+}
+
+func TestClient_FetchPlanets(t *testing.T) {
+	t.Run("Aggregates SWAPI pages into one response", func(t *testing.T) {
+		fixture := loadTestFixture(t, "planets_response.json")
+		var (
+			mu             sync.Mutex
+			requestedPages []string
+		)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/planets/", r.URL.Path)
+			mu.Lock()
+			requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+			mu.Unlock()
+			_, _ = w.Write(fixture)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, server.Client(), 4)
+		result, err := client.FetchPlanets(context.Background(), 1, 15, "")
+		require.NoError(t, err)
+
+		// The fixture is a page of 2 items with a next link, so SWAPI pages hold 2 items
+		// and page 1 of 15 is SWAPI pages 1-8; page 1 is fetched only once
+		assert.ElementsMatch(t, []string{"1", "2", "3", "4", "5", "6", "7", "8"}, requestedPages)
+		assert.Equal(t, 60, result.Count)
+		assert.Equal(t, 1, result.Page)
+		assert.Len(t, result.Results, 15)
+	})
+
+	t.Run("Fetches exactly the SWAPI pages backing the requested page size", func(t *testing.T) {
+		var (
+			mu             sync.Mutex
+			requestedPages []string
+		)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			page := r.URL.Query().Get("page")
+			mu.Lock()
+			requestedPages = append(requestedPages, page)
+			mu.Unlock()
+			writePlanetsPage(w, r, 10, 200)
+		}))
+		defer server.Close()
+
+		// Page 3 of 25 is items 50-74, i.e. SWAPI pages 6-8, after page 1 reveals the page size
+		client := NewClient(server.URL, server.Client(), 4)
+		result, err := client.FetchPlanets(context.Background(), 3, 25, "")
+		require.NoError(t, err)
+
+		assert.ElementsMatch(t, []string{"1", "6", "7", "8"}, requestedPages)
+		require.Len(t, result.Results, 25)
+		assert.Equal(t, "page 6", result.Results[0].Name)
+		assert.Equal(t, "page 8", result.Results[24].Name)
+	})
+}
+
+func TestClient_FetchPlanets_Concurrency(t *testing.T) {
+	t.Run("Bounds parallel fetches and keeps page order", func(t *testing.T) {
+		var inFlight, maxInFlight atomic.Int32
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				seen := maxInFlight.Load()
+				if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+
+			time.Sleep(20 * time.Millisecond) // Let other fetches overlap
+			writePlanetsPage(w, r, 10, 200)
+		}))
+		defer server.Close()
+
+		// One page of 50 is SWAPI pages 1-5
+		client := NewClient(server.URL, server.Client(), 2)
+		result, err := client.FetchPlanets(context.Background(), 1, 50, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(2), maxInFlight.Load())
+		require.Len(t, result.Results, 50)
+		for i, planet := range result.Results {
+			assert.Equal(t, fmt.Sprintf("page %d", i/10+1), planet.Name)
+		}
+	})
+
+	t.Run("First failure cancels the other fetches", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("page") {
+			case "1":
+				writePlanetsPage(w, r, 10, 200) // Reveals the page size
+			case "2":
+				w.WriteHeader(http.StatusInternalServerError)
+			default:
+				// Other pages only answer once the client gives up on them
+				select {
+				case <-r.Context().Done():
+				case <-time.After(5 * time.Second):
+					writePlanetsPage(w, r, 10, 200)
+				}
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, server.Client(), 3)
+		start := time.Now()
+		_, err := client.FetchPlanets(context.Background(), 1, 40, "")
+
+		require.Error(t, err)
+		assert.Less(t, time.Since(start), 2*time.Second)
+	})
+}
+
+func TestClient_FetchPlanets_PageSizeDetection(t *testing.T) {
+	tests := []struct {
+		name      string
+		sizes     []int // Upstream page size before each request
+		count     int
+		page      int
+		pageSize  int
+		wantPages [][]string // SWAPI pages fetched by each request
+		wantNames []string   // Of the last response
+	}{
+		{
+			name:      "Mirror with 4 items per page",
+			sizes:     []int{4},
+			count:     30,
+			page:      2,
+			pageSize:  5,
+			wantPages: [][]string{{"1", "2", "3"}},
+			wantNames: []string{"page 2", "page 2", "page 2", "page 3", "page 3"},
+		},
+		{
+			name:      "Short last page ends the collection",
+			sizes:     []int{4},
+			count:     10,
+			page:      1,
+			pageSize:  20,
+			wantPages: [][]string{{"1", "2", "3", "4", "5"}},
+			wantNames: []string{"page 1", "page 1", "page 1", "page 1", "page 2", "page 2", "page 2", "page 2", "page 3", "page 3"},
+		},
+		{
+			name:      "Single upstream page needs no detection",
+			sizes:     []int{10},
+			count:     3,
+			page:      1,
+			pageSize:  2,
+			wantPages: [][]string{{"1"}},
+			wantNames: []string{"page 1", "page 1"},
+		},
+		{
+			name:      "Detected size is cached per endpoint",
+			sizes:     []int{4, 4},
+			count:     30,
+			page:      3,
+			pageSize:  4,
+			wantPages: [][]string{{"1", "3"}, {"3"}},
+			wantNames: []string{"page 3", "page 3", "page 3", "page 3"},
+		},
+		{
+			name:      "Changed upstream size is re-learned",
+			sizes:     []int{4, 5},
+			count:     30,
+			page:      3,
+			pageSize:  4,
+			wantPages: [][]string{{"1", "3"}, {"3", "2", "3"}},
+			wantNames: []string{"page 2", "page 2", "page 3", "page 3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu             sync.Mutex
+				upstreamSize   int
+				requestedPages []string
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+				size := upstreamSize
+				mu.Unlock()
+				writePlanetsPage(w, r, size, tt.count)
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, server.Client(), 4)
+			var result domain.PaginatedResponse[domain.Planet]
+			for i, size := range tt.sizes {
+				mu.Lock()
+				upstreamSize, requestedPages = size, nil
+				mu.Unlock()
+
+				var err error
+				result, err = client.FetchPlanets(context.Background(), tt.page, tt.pageSize, "")
+				require.NoError(t, err)
+				assert.ElementsMatch(t, tt.wantPages[i], requestedPages)
+			}
+
+			var names []string
+			for _, planet := range result.Results {
+				names = append(names, planet.Name)
+			}
+			assert.Equal(t, tt.count, result.Count)
+			assert.Equal(t, tt.wantNames, names)
+		})
+	}
+}
+
+// writePlanetsPage answers a SWAPI planets list request for a collection of count
+// planets served pageSize at a time. Planets are named after their page, e.g.
+// "page 3", and every page but the last links to the next one.
+func writePlanetsPage(w http.ResponseWriter, r *http.Request, pageSize, count int) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	start := min((page-1)*pageSize, count)
+	end := min(start+pageSize, count)
+
+	results := make([]PlanetDTO, end-start)
+	for i := range results {
+		results[i].Name = fmt.Sprintf("page %d", page)
+		results[i].Created = "2014-12-09T13:50:49.641000Z"
+	}
+
+	resp := SWAPIPlanetsResponse{Count: count, Results: results}
+	if end < count {
+		next := fmt.Sprintf("%s%s?page=%d", "http://"+r.Host, r.URL.Path, page+1)
+		resp.Next = &next
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func TestClient_FetchPlanetByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		status     int
+		wantPath   string
+		wantErr    error
+		wantPlanet string
+	}{
+		{
+			name:       "Success",
+			id:         "1",
+			status:     http.StatusOK,
+			wantPath:   "/planets/1/",
+			wantPlanet: "Tatooine",
+		},
+		{
+			name:     "Not found maps to domain error",
+			id:       "999",
+			status:   http.StatusNotFound,
+			wantPath: "/planets/999/",
+			wantErr:  errors.ErrPlanetNotFound,
+		},
+		{
+			name:     "Crafted id cannot change upstream path",
+			id:       "../people/1",
+			status:   http.StatusNotFound,
+			wantPath: "/planets/..%2Fpeople%2F1/",
+			wantErr:  errors.ErrPlanetNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.wantPath, r.URL.EscapedPath())
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"name":"Tatooine","created":"2014-12-09T13:50:49.641000Z"}`))
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, server.Client(), 4)
+			planet, err := client.FetchPlanetByID(context.Background(), tt.id)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPlanet, planet.Name)
+		})
+	}
+}
+
+func TestClient_APIRetrievePersonByID(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       string
+		status   int
+		wantPath string
+		wantErr  error
+	}{
+		{
+			name:     "Success",
+			id:       "1",
+			status:   http.StatusOK,
+			wantPath: "/people/1/",
+		},
+		{
+			name:     "Not found maps to domain error",
+			id:       "9999",
+			status:   http.StatusNotFound,
+			wantPath: "/people/9999/",
+			wantErr:  errors.ErrPersonNotFound,
+		},
+		{
+			name:     "Crafted id is escaped into a single path segment",
+			id:       "1/?search=vader",
+			status:   http.StatusNotFound,
+			wantPath: "/people/1%2F%3Fsearch=vader/",
+			wantErr:  errors.ErrPersonNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.wantPath, r.URL.EscapedPath())
+				assert.Empty(t, r.URL.RawQuery)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"name":"Luke Skywalker","mass":"77","created":"2014-12-09T13:50:51.644000Z"}`))
+			}))
+			defer server.Close()
+
+			client := NewClient(server.URL, server.Client(), 4)
+			person, err := client.APIRetrievePersonByID(context.Background(), tt.id)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Luke Skywalker", person.Name)
+			assert.Equal(t, domain.Measure(77), person.Mass)
+		})
+	}
+}
+
+func TestClient_FetchFilms(t *testing.T) {
+	t.Run("Parse and map SWAPI films response", func(t *testing.T) {
+		fixture := loadTestFixture(t, "films_response.json")
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/films/", r.URL.Path)
+			_, _ = w.Write(fixture)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, server.Client(), 4)
+		result, err := client.FetchFilms(context.Background(), 1, 15, "")
+		require.NoError(t, err)
+
+		assert.Equal(t, 6, result.Count)
+		require.Len(t, result.Results, 2)
+		assert.Equal(t, "A New Hope", result.Results[0].Title)
+		assert.Equal(t, 4, result.Results[0].EpisodeID)
+		assert.Equal(t, "1977-05-25", result.Results[0].ReleaseDate)
+		assert.Equal(t, "2014-12-10", result.Results[0].Created)
+	})
+
+	t.Run("Film not found maps to domain error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, server.Client(), 4)
+		_, err := client.FetchFilmByID(context.Background(), "42")
+		assert.ErrorIs(t, err, errors.ErrFilmNotFound)
+	})
+}
+
+func TestClient_APIRetrieveAllPeople(t *testing.T) {
+	t.Run("Follows next links until the last page", func(t *testing.T) {
+		var server *httptest.Server
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/people/", r.URL.Path)
+
+			resp := SWAPIListResponse[PersonDTO]{Count: 3}
+			switch r.URL.Query().Get("page") {
+			case "1":
+				next := server.URL + "/people/?page=2"
+				resp.Next = &next
+				resp.Results = []PersonDTO{{Name: "Luke Skywalker"}, {Name: "C-3PO"}}
+			case "2":
+				resp.Results = []PersonDTO{{Name: "R2-D2"}}
+			default:
+				t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, server.Client(), 4)
+		people, err := client.APIRetrieveAllPeople(context.Background())
+		require.NoError(t, err)
+
+		require.Len(t, people, 3)
+		assert.Equal(t, "Luke Skywalker", people[0].Name)
+		assert.Equal(t, "R2-D2", people[2].Name)
 	})
 }
@@ -4,29 +4,124 @@ package swapi
 // so we can control parsing in the mapper.
 
 type PersonDTO struct {
-	Name    string   `json:"name"`
-	Mass    string   `json:"mass"`
-	Created string   `json:"created"`
-	Films   []string `json:"films"`
-}
-
-type PlanetDTO struct {
 	Name      string   `json:"name"`
-	Residents []string `json:"residents"`
+	Height    string   `json:"height"`
+	Mass      string   `json:"mass"`
+	HairColor string   `json:"hair_color"`
+	SkinColor string   `json:"skin_color"`
+	EyeColor  string   `json:"eye_color"`
+	BirthYear string   `json:"birth_year"`
+	Gender    string   `json:"gender"`
 	Created   string   `json:"created"`
+	Edited    string   `json:"edited"`
 	Films     []string `json:"films"`
+	Homeworld string   `json:"homeworld"`
+	Species   []string `json:"species"`
+	Vehicles  []string `json:"vehicles"`
+	Starships []string `json:"starships"`
+	URL       string   `json:"url"`
+}
+
+type PlanetDTO struct {
+	Name           string   `json:"name"`
+	RotationPeriod string   `json:"rotation_period"`
+	OrbitalPeriod  string   `json:"orbital_period"`
+	Diameter       string   `json:"diameter"`
+	Climate        string   `json:"climate"`
+	Gravity        string   `json:"gravity"`
+	Terrain        string   `json:"terrain"`
+	SurfaceWater   string   `json:"surface_water"`
+	Population     string   `json:"population"`
+	Residents      []string `json:"residents"`
+	Created        string   `json:"created"`
+	Films          []string `json:"films"`
+	URL            string   `json:"url"`
+}
+
+type FilmDTO struct {
+	Title        string   `json:"title"`
+	EpisodeID    int      `json:"episode_id"`
+	OpeningCrawl string   `json:"opening_crawl"`
+	Director     string   `json:"director"`
+	Producer     string   `json:"producer"`
+	ReleaseDate  string   `json:"release_date"`
+	Characters   []string `json:"characters"`
+	Planets      []string `json:"planets"`
+	Created      string   `json:"created"`
+	URL          string   `json:"url"`
+}
+
+type StarshipDTO struct {
+	Name                 string   `json:"name"`
+	Model                string   `json:"model"`
+	Manufacturer         string   `json:"manufacturer"`
+	CostInCredits        string   `json:"cost_in_credits"`
+	Length               string   `json:"length"`
+	MaxAtmospheringSpeed string   `json:"max_atmosphering_speed"`
+	Crew                 string   `json:"crew"`
+	Passengers           string   `json:"passengers"`
+	CargoCapacity        string   `json:"cargo_capacity"`
+	Consumables          string   `json:"consumables"`
+	HyperdriveRating     string   `json:"hyperdrive_rating"`
+	MGLT                 string   `json:"MGLT"`
+	StarshipClass        string   `json:"starship_class"`
+	Pilots               []string `json:"pilots"`
+	Films                []string `json:"films"`
+	Created              string   `json:"created"`
+	URL                  string   `json:"url"`
+}
+
+type VehicleDTO struct {
+	Name                 string   `json:"name"`
+	Model                string   `json:"model"`
+	Manufacturer         string   `json:"manufacturer"`
+	CostInCredits        string   `json:"cost_in_credits"`
+	Length               string   `json:"length"`
+	MaxAtmospheringSpeed string   `json:"max_atmosphering_speed"`
+	Crew                 string   `json:"crew"`
+	Passengers           string   `json:"passengers"`
+	CargoCapacity        string   `json:"cargo_capacity"`
+	Consumables          string   `json:"consumables"`
+	VehicleClass         string   `json:"vehicle_class"`
+	Pilots               []string `json:"pilots"`
+	Films                []string `json:"films"`
+	Created              string   `json:"created"`
+	URL                  string   `json:"url"`
 }
 
-type SWAPIPeopleResponse struct {
-	Count    int         `json:"count"`
-	Next     *string     `json:"next"`
-	Previous *string     `json:"previous"`
-	Results  []PersonDTO `json:"results"`
+type SpeciesDTO struct {
+	Name            string   `json:"name"`
+	Classification  string   `json:"classification"`
+	Designation     string   `json:"designation"`
+	AverageHeight   string   `json:"average_height"`
+	SkinColors      string   `json:"skin_colors"`
+	HairColors      string   `json:"hair_colors"`
+	EyeColors       string   `json:"eye_colors"`
+	AverageLifespan string   `json:"average_lifespan"`
+	Homeworld       *string  `json:"homeworld"` // null for species without a homeworld
+	Language        string   `json:"language"`
+	People          []string `json:"people"`
+	Films           []string `json:"films"`
+	Created         string   `json:"created"`
+	URL             string   `json:"url"`
 }
 
-type SWAPIPlanetsResponse struct {
-	Count    int         `json:"count"`
-	Next     *string     `json:"next"`
-	Previous *string     `json:"previous"`
-	Results  []PlanetDTO `json:"results"`
+// SWAPIListResponse mirrors the envelope SWAPI wraps around every list endpoint.
+type SWAPIListResponse[T any] struct {
+	Count    int     `json:"count"`
+	Next     *string `json:"next"`
+	Previous *string `json:"previous"`
+	Results  []T     `json:"results"`
 }
+
+type SWAPIPeopleResponse = SWAPIListResponse[PersonDTO]
+
+type SWAPIPlanetsResponse = SWAPIListResponse[PlanetDTO]
+
+type SWAPIFilmsResponse = SWAPIListResponse[FilmDTO]
+
+type SWAPIStarshipsResponse = SWAPIListResponse[StarshipDTO]
+
+type SWAPIVehiclesResponse = SWAPIListResponse[VehicleDTO]
+
+type SWAPISpeciesResponse = SWAPIListResponse[SpeciesDTO]
@@ -0,0 +1,255 @@
+package swapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/pagination"
+)
+
+// retrieveList fetches the SWAPI pages backing the requested page and builds
+// a paginated response of pageSize domain objects.
+//
+// The upstream page size is not assumed: the first request to an endpoint fetches
+// its page 1 and takes the size from it, since only a page with a "next" link is
+// known to be full. The size is cached per endpoint. Should a later full page
+// disagree with the cache, the size is re-learned and the page aggregated again.
+func retrieveList[D, T any](ctx context.Context, c *Client, endpoint string, page, pageSize int, search string, mapFn func([]D) []T) (domain.PaginatedResponse[T], error) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	externalPageSize, known := c.pageSizes.get(endpoint)
+	var first *SWAPIListResponse[D]
+	if !known {
+		var err error
+		first, err = fetchListPage[D](ctx, c, endpoint, 1, search)
+		if err != nil {
+			return domain.PaginatedResponse[T]{}, err
+		}
+
+		// Everything fits on one upstream page, whatever its size
+		if first.Next == nil || len(first.Results) == 0 {
+			result := pagination.Paginate(mapFn(first.Results), page, pageSize)
+			result.Count = first.Count
+			return result, nil
+		}
+
+		externalPageSize = len(first.Results)
+		c.pageSizes.set(endpoint, externalPageSize)
+	}
+
+	for attempt := 0; ; attempt++ {
+		// Determine which SWAPI pages back the requested page at this size
+		strategy := pagination.NewAggregationStrategy(page, pageSize, externalPageSize)
+
+		// Fetch aggregated data from SWAPI
+		pages, err := fetchAggregated(ctx, c, endpoint, strategy, search, first)
+		if err != nil {
+			return domain.PaginatedResponse[T]{}, err
+		}
+
+		if observed := mismatchedPageSize(pages, externalPageSize); observed != 0 {
+			if attempt > 0 {
+				return domain.PaginatedResponse[T]{}, fmt.Errorf("SWAPI %s pages changed size during the request", endpoint)
+			}
+			// The upstream page size changed; offsets computed from the old one are wrong
+			externalPageSize = observed
+			c.pageSizes.set(endpoint, externalPageSize)
+			first = nil
+			continue
+		}
+
+		items, totalCount := aggregatePages(pages, mapFn)
+
+		// Use pagination package to build the response
+		return pagination.BuildResponse(items, totalCount, strategy), nil
+	}
+}
+
+// fetchAggregated fetches the SWAPI pages the strategy needs, in page order.
+// first, if not nil, is the already fetched page 1 and is reused when needed.
+// Up to c.fetchConcurrency pages are fetched at once; the first failure cancels the
+// remaining fetches and is returned.
+func fetchAggregated[D any](ctx context.Context, c *Client, endpoint string, strategy *pagination.AggregationStrategy, search string, first *SWAPIListResponse[D]) ([]*SWAPIListResponse[D], error) {
+	startPage, _, pagesNeeded := strategy.CalculatePageRange()
+
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		pages    = make([]*SWAPIListResponse[D], pagesNeeded) // Indexed by position in the range
+		sem      = make(chan struct{}, c.fetchConcurrency)
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	// Fetch all necessary SWAPI pages
+	for i := range pagesNeeded {
+		if startPage+i == 1 && first != nil {
+			pages[i] = first
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-fetchCtx.Done():
+				return
+			}
+			if fetchCtx.Err() != nil {
+				return // Cancelled while waiting for a slot
+			}
+
+			dto, err := fetchListPage[D](fetchCtx, c, endpoint, startPage+i, search)
+			if err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			pages[i] = dto // Each goroutine owns its own slot
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	// Parent context may have been cancelled before any fetch reported an error
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return pages, nil
+}
+
+// mismatchedPageSize returns the length of the first full page, i.e. one SWAPI
+// links a next page from, that does not hold expected items, or 0 if all do.
+func mismatchedPageSize[D any](pages []*SWAPIListResponse[D], expected int) int {
+	for _, dto := range pages {
+		if dto.Next == nil {
+			break
+		}
+		if len(dto.Results) != expected {
+			return len(dto.Results)
+		}
+	}
+	return 0
+}
+
+// aggregatePages maps the results of consecutive SWAPI pages in order and returns
+// them with the total count of the first page.
+func aggregatePages[D, T any](pages []*SWAPIListResponse[D], mapFn func([]D) []T) ([]T, int) {
+	// Store total count from first response
+	totalCount := pages[0].Count
+
+	var allItems []T
+	for _, dto := range pages {
+		// Map DTOs to domain objects
+		allItems = append(allItems, mapFn(dto.Results)...)
+
+		// The page without a next link is the last one; any later pages are past the end
+		if dto.Next == nil {
+			break
+		}
+	}
+
+	return allItems, totalCount
+}
+
+// retrieveAll fetches every page of a SWAPI list endpoint, following the
+// upstream "next" links until the last page, and maps the results in order.
+func retrieveAll[D, T any](ctx context.Context, c *Client, endpoint string, mapFn func([]D) []T) ([]T, error) {
+	var allItems []T
+
+	for page := 1; ; page++ {
+		dto, err := fetchListPage[D](ctx, c, endpoint, page, "")
+		if err != nil {
+			return nil, err
+		}
+
+		allItems = append(allItems, mapFn(dto.Results)...)
+
+		// Stop when SWAPI reports no further page (or returns nothing at all)
+		if dto.Next == nil || len(dto.Results) == 0 {
+			break
+		}
+	}
+
+	return allItems, nil
+}
+
+// fetchListPage performs HTTP request to a SWAPI list endpoint.
+func fetchListPage[D any](ctx context.Context, c *Client, endpoint string, page int, search string) (*SWAPIListResponse[D], error) {
+	url := BuildURL(c.baseURL, endpoint, page, search)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Validate HTTP status code
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			// For list endpoints, 404 might mean empty results
+			return &SWAPIListResponse[D]{Count: 0, Results: []D{}}, nil
+		}
+		return nil, handleHTTPErrorForList(resp.StatusCode)
+	}
+
+	var response SWAPIListResponse[D]
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// retrieveResource fetches a single SWAPI resource by ID and maps it to the domain.
+// resourceType selects the not-found error (see handleHTTPError).
+func retrieveResource[D, T any](ctx context.Context, c *Client, endpoint, id, resourceType string, mapFn func(D) T) (T, error) {
+	var zero T
+	url := BuildResourceURL(c.baseURL, endpoint, id)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return zero, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return zero, err
+	}
+	defer resp.Body.Close()
+
+	// Validate HTTP status code
+	if resp.StatusCode != http.StatusOK {
+		return zero, handleHTTPError(resp.StatusCode, resourceType)
+	}
+
+	var dto D
+	if err := json.NewDecoder(resp.Body).Decode(&dto); err != nil {
+		return zero, err
+	}
+
+	return mapFn(dto), nil
+}
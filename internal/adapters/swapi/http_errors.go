@@ -13,10 +13,20 @@ func handleHTTPError(statusCode int, resourceType string) error {
 	switch statusCode {
 	case http.StatusNotFound:
 		// Return appropriate error based on resource type
-		if resourceType == "person" {
+		switch resourceType {
+		case "person":
 			return errors.ErrPersonNotFound
+		case "film":
+			return errors.ErrFilmNotFound
+		case "starship":
+			return errors.ErrStarshipNotFound
+		case "vehicle":
+			return errors.ErrVehicleNotFound
+		case "species":
+			return errors.ErrSpeciesNotFound
+		default:
+			return errors.ErrPlanetNotFound
 		}
-		return errors.ErrPlanetNotFound
 
 	case http.StatusTooManyRequests:
 		return errors.ErrRateLimitExceeded
@@ -2,31 +2,96 @@ package swapi
 
 import (
 	"log"
+	"strings"
 	"time"
 
 	"github.com/stressedbypull/swapi-connector/internal/adapters/http/validation"
 	"github.com/stressedbypull/swapi-connector/internal/domain"
 )
 
-// MapPersonDTOToDomain converts a SWAPI PersonDTO into domain.Person.
-func MapPersonDTOToDomain(dto PersonDTO) domain.Person {
-	var created string
-	parsedTime, err := time.Parse(time.RFC3339, dto.Created)
+// formatCreated converts SWAPI's RFC3339 timestamp to a YYYY-MM-DD date.
+// Returns an empty string (and logs a warning) if the timestamp cannot be parsed.
+func formatCreated(raw string) string {
+	parsedTime, err := time.Parse(time.RFC3339, raw)
 	if err != nil {
-		log.Printf("warn: failed to parse created date '%s': %v", dto.Created, err)
-		created = ""
-	} else {
-		// Format as YYYY-MM-DD (date only)
-		created = parsedTime.Format("2006-01-02")
+		log.Printf("warn: failed to parse created date '%s': %v", raw, err)
+		return ""
 	}
 
-	mass := validation.ParseMass(dto.Mass)
+	// Format as YYYY-MM-DD (date only)
+	return parsedTime.Format("2006-01-02")
+}
+
+// resourceID returns the numeric ID in a SWAPI resource URL, or "" if there is none.
+func resourceID(url string) string {
+	id, _ := domain.ResourceIDFromURL(url)
+	return id
+}
+
+// normalizeAttribute trims a descriptive SWAPI value and maps the missing-value
+// spellings to domain.AttributeUnknown / domain.AttributeNotApplicable.
+// Examples: " blond " -> "blond", "N/A" -> "n/a", "" -> "unknown"
+func normalizeAttribute(raw string) string {
+	trimmed := strings.TrimSpace(raw)
+	switch strings.ToLower(trimmed) {
+	case "", domain.AttributeUnknown:
+		return domain.AttributeUnknown
+	case domain.AttributeNotApplicable:
+		return domain.AttributeNotApplicable
+	}
+	return trimmed
+}
+
+// splitAttributeList splits SWAPI's comma-separated descriptive values into a slice,
+// normalizing each item like normalizeAttribute.
+// Examples: "temperate, tropical" -> ["temperate", "tropical"], "unknown" -> ["unknown"]
+func splitAttributeList(raw string) []string {
+	parts := strings.Split(raw, ",")
+	items := make([]string, 0, len(parts))
+	for _, part := range parts {
+		items = append(items, normalizeAttribute(part))
+	}
+	return items
+}
+
+// measure parses a SWAPI numeric value into a measurement that is unknown
+// when SWAPI reports "unknown", "n/a" or a value that is not a number.
+// Examples: "78.2" -> 78.2, "1,358" -> 1358, "unknown" -> unknown
+func measure(raw string) domain.Measurement {
+	value, known := validation.ParseMeasurement(raw)
+	if !known {
+		return domain.Measurement{}
+	}
+	return domain.Measure(value)
+}
+
+// MapPersonDTOToDomain converts a SWAPI PersonDTO into domain.Person.
+func MapPersonDTOToDomain(dto PersonDTO) domain.Person {
+	created := formatCreated(dto.Created)
+	edited := formatCreated(dto.Edited)
 
 	return domain.Person{
-		Name:   dto.Name,
-		Mass:   mass,
-		Create: created,
-		Films:  dto.Films,
+		ID:          resourceID(dto.URL),
+		Name:        dto.Name,
+		Height:      measure(dto.Height),
+		Mass:        measure(dto.Mass),
+		HairColor:   normalizeAttribute(dto.HairColor),
+		SkinColor:   normalizeAttribute(dto.SkinColor),
+		EyeColor:    normalizeAttribute(dto.EyeColor),
+		BirthYear:   normalizeAttribute(dto.BirthYear),
+		Gender:      normalizeAttribute(dto.Gender),
+		Create:      created,
+		Edited:      edited,
+		Films:       dto.Films,
+		FilmIDs:     domain.ResourceIDsFromURLs(dto.Films),
+		Homeworld:   dto.Homeworld,
+		HomeworldID: resourceID(dto.Homeworld),
+		Species:     dto.Species,
+		SpeciesIDs:  domain.ResourceIDsFromURLs(dto.Species),
+		Vehicles:    dto.Vehicles,
+		VehicleIDs:  domain.ResourceIDsFromURLs(dto.Vehicles),
+		Starships:   dto.Starships,
+		StarshipIDs: domain.ResourceIDsFromURLs(dto.Starships),
 	}
 }
 
@@ -46,21 +111,24 @@ func MapPeopleToDomain(dtos []PersonDTO) []domain.Person {
 
 // MapPlanetDTOToDomain converts a SWAPI PlanetDTO into domain.Planet.
 func MapPlanetDTOToDomain(dto PlanetDTO) domain.Planet {
-	var created string
-	parsedTime, err := time.Parse(time.RFC3339, dto.Created)
-	if err != nil {
-		log.Printf("warn: failed to parse created date '%s': %v", dto.Created, err)
-		created = ""
-	} else {
-		// Format as YYYY-MM-DD (date only)
-		created = parsedTime.Format("2006-01-02")
-	}
+	created := formatCreated(dto.Created)
 
 	return domain.Planet{
-		Name:     dto.Name,
-		Resident: dto.Residents,
-		Created:  created,
-		Films:    dto.Films,
+		ID:             resourceID(dto.URL),
+		Name:           dto.Name,
+		RotationPeriod: measure(dto.RotationPeriod),
+		OrbitalPeriod:  measure(dto.OrbitalPeriod),
+		Diameter:       measure(dto.Diameter),
+		Gravity:        normalizeAttribute(dto.Gravity),
+		Population:     measure(dto.Population),
+		SurfaceWater:   measure(dto.SurfaceWater),
+		Climate:        splitAttributeList(dto.Climate),
+		Terrain:        splitAttributeList(dto.Terrain),
+		Resident:       dto.Residents,
+		ResidentIDs:    domain.ResourceIDsFromURLs(dto.Residents),
+		Created:        created,
+		Films:          dto.Films,
+		FilmIDs:        domain.ResourceIDsFromURLs(dto.Films),
 	}
 }
 
@@ -77,3 +145,158 @@ func MapPlanetsToDomain(dtos []PlanetDTO) []domain.Planet {
 
 	return planets
 }
+
+// MapFilmDTOToDomain converts a SWAPI FilmDTO into domain.Film.
+func MapFilmDTOToDomain(dto FilmDTO) domain.Film {
+	created := formatCreated(dto.Created)
+
+	return domain.Film{
+		ID:           resourceID(dto.URL),
+		Title:        dto.Title,
+		EpisodeID:    dto.EpisodeID,
+		OpeningCrawl: dto.OpeningCrawl,
+		Director:     dto.Director,
+		Producer:     dto.Producer,
+		ReleaseDate:  dto.ReleaseDate,
+		Characters:   dto.Characters,
+		CharacterIDs: domain.ResourceIDsFromURLs(dto.Characters),
+		Planets:      dto.Planets,
+		PlanetIDs:    domain.ResourceIDsFromURLs(dto.Planets),
+		Created:      created,
+	}
+}
+
+// MapFilmsToDomain converts a slice of FilmDTOs to domain.Film slice.
+func MapFilmsToDomain(dtos []FilmDTO) []domain.Film {
+	if len(dtos) == 0 {
+		return []domain.Film{}
+	}
+
+	films := make([]domain.Film, 0, len(dtos))
+	for _, dto := range dtos {
+		films = append(films, MapFilmDTOToDomain(dto))
+	}
+
+	return films
+}
+
+// MapStarshipDTOToDomain converts a SWAPI StarshipDTO into domain.Starship.
+// Numeric specs are parsed defensively: "unknown", "n/a" and malformed values are unknown.
+func MapStarshipDTOToDomain(dto StarshipDTO) domain.Starship {
+	return domain.Starship{
+		ID:                   resourceID(dto.URL),
+		Name:                 dto.Name,
+		Model:                dto.Model,
+		Manufacturer:         dto.Manufacturer,
+		CostInCredits:        measure(dto.CostInCredits),
+		Length:               measure(dto.Length),
+		MaxAtmospheringSpeed: measure(dto.MaxAtmospheringSpeed),
+		Crew:                 dto.Crew,
+		Passengers:           dto.Passengers,
+		CargoCapacity:        measure(dto.CargoCapacity),
+		Consumables:          dto.Consumables,
+		HyperdriveRating:     measure(dto.HyperdriveRating),
+		MGLT:                 measure(dto.MGLT),
+		StarshipClass:        dto.StarshipClass,
+		Pilots:               dto.Pilots,
+		PilotIDs:             domain.ResourceIDsFromURLs(dto.Pilots),
+		Films:                dto.Films,
+		FilmIDs:              domain.ResourceIDsFromURLs(dto.Films),
+		Created:              formatCreated(dto.Created),
+	}
+}
+
+// MapStarshipsToDomain converts a slice of StarshipDTOs to domain.Starship slice.
+func MapStarshipsToDomain(dtos []StarshipDTO) []domain.Starship {
+	if len(dtos) == 0 {
+		return []domain.Starship{}
+	}
+
+	starships := make([]domain.Starship, 0, len(dtos))
+	for _, dto := range dtos {
+		starships = append(starships, MapStarshipDTOToDomain(dto))
+	}
+
+	return starships
+}
+
+// MapVehicleDTOToDomain converts a SWAPI VehicleDTO into domain.Vehicle.
+// Numeric specs are parsed defensively: "unknown", "n/a" and malformed values are unknown.
+func MapVehicleDTOToDomain(dto VehicleDTO) domain.Vehicle {
+	return domain.Vehicle{
+		ID:                   resourceID(dto.URL),
+		Name:                 dto.Name,
+		Model:                dto.Model,
+		Manufacturer:         dto.Manufacturer,
+		CostInCredits:        measure(dto.CostInCredits),
+		Length:               measure(dto.Length),
+		MaxAtmospheringSpeed: measure(dto.MaxAtmospheringSpeed),
+		Crew:                 dto.Crew,
+		Passengers:           dto.Passengers,
+		CargoCapacity:        measure(dto.CargoCapacity),
+		Consumables:          dto.Consumables,
+		VehicleClass:         dto.VehicleClass,
+		Pilots:               dto.Pilots,
+		PilotIDs:             domain.ResourceIDsFromURLs(dto.Pilots),
+		Films:                dto.Films,
+		FilmIDs:              domain.ResourceIDsFromURLs(dto.Films),
+		Created:              formatCreated(dto.Created),
+	}
+}
+
+// MapVehiclesToDomain converts a slice of VehicleDTOs to domain.Vehicle slice.
+func MapVehiclesToDomain(dtos []VehicleDTO) []domain.Vehicle {
+	if len(dtos) == 0 {
+		return []domain.Vehicle{}
+	}
+
+	vehicles := make([]domain.Vehicle, 0, len(dtos))
+	for _, dto := range dtos {
+		vehicles = append(vehicles, MapVehicleDTOToDomain(dto))
+	}
+
+	return vehicles
+}
+
+// MapSpeciesDTOToDomain converts a SWAPI SpeciesDTO into domain.Species.
+// Average height and lifespan are parsed defensively: "unknown", "n/a" and "indefinite" are unknown.
+func MapSpeciesDTOToDomain(dto SpeciesDTO) domain.Species {
+	var homeworld string
+	if dto.Homeworld != nil {
+		homeworld = *dto.Homeworld
+	}
+
+	return domain.Species{
+		ID:              resourceID(dto.URL),
+		Name:            dto.Name,
+		Classification:  dto.Classification,
+		Designation:     dto.Designation,
+		AverageHeight:   measure(dto.AverageHeight),
+		AverageLifespan: measure(dto.AverageLifespan),
+		SkinColors:      dto.SkinColors,
+		HairColors:      dto.HairColors,
+		EyeColors:       dto.EyeColors,
+		Homeworld:       homeworld,
+		HomeworldID:     resourceID(homeworld),
+		Language:        dto.Language,
+		People:          dto.People,
+		PeopleIDs:       domain.ResourceIDsFromURLs(dto.People),
+		Films:           dto.Films,
+		FilmIDs:         domain.ResourceIDsFromURLs(dto.Films),
+		Created:         formatCreated(dto.Created),
+	}
+}
+
+// MapSpeciesToDomain converts a slice of SpeciesDTOs to domain.Species slice.
+func MapSpeciesToDomain(dtos []SpeciesDTO) []domain.Species {
+	if len(dtos) == 0 {
+		return []domain.Species{}
+	}
+
+	species := make([]domain.Species, 0, len(dtos))
+	for _, dto := range dtos {
+		species = append(species, MapSpeciesDTOToDomain(dto))
+	}
+
+	return species
+}
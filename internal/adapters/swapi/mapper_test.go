@@ -12,7 +12,7 @@ func TestMapPersonDTOToDomain(t *testing.T) {
 	// Given: a PersonDTO from SWAPI
 	dto := PersonDTO{
 		Name:    "Luke Skywalker",
-		Mass:    "77",
+		Mass:    "78.2",
 		Created: "2014-12-09T13:50:51.644000Z",
 		Films: []string{
 			"https://swapi.dev/api/films/1/",
@@ -26,7 +26,7 @@ func TestMapPersonDTOToDomain(t *testing.T) {
 	// Then: it should be correctly converted (date only in YYYY-MM-DD format)
 	expected := domain.Person{
 		Name:   "Luke Skywalker",
-		Mass:   77,
+		Mass:   domain.Measure(78.2), // Decimals are kept
 		Create: "2014-12-09",
 		Films: []string{
 			"https://swapi.dev/api/films/1/",
@@ -39,3 +39,304 @@ func TestMapPersonDTOToDomain(t *testing.T) {
 	assert.Equal(t, expected.Create, result.Create)
 	assert.Equal(t, expected.Films, result.Films)
 }
+
+func TestMapPersonDTOToDomain_Attributes(t *testing.T) {
+	tests := []struct {
+		name string
+		dto  PersonDTO
+		want domain.Person
+	}{
+		{
+			name: "all attributes known",
+			dto: PersonDTO{
+				Name:      "Luke Skywalker",
+				Height:    "172",
+				Mass:      "77",
+				HairColor: "blond",
+				SkinColor: "fair",
+				EyeColor:  "blue",
+				BirthYear: "19BBY",
+				Gender:    "male",
+				Created:   "2014-12-09T13:50:51.644000Z",
+				Edited:    "2014-12-20T21:17:56.891000Z",
+				Films:     []string{"https://swapi.dev/api/films/1/", "https://swapi.dev/api/films/2/"},
+				Homeworld: "https://swapi.dev/api/planets/1/",
+				Species:   []string{},
+				Vehicles:  []string{"https://swapi.dev/api/vehicles/14/"},
+				Starships: []string{"https://swapi.dev/api/starships/12/"},
+				URL:       "https://swapi.dev/api/people/1/",
+			},
+			want: domain.Person{
+				ID:          "1",
+				Name:        "Luke Skywalker",
+				Height:      domain.Measure(172),
+				Mass:        domain.Measure(77),
+				HairColor:   "blond",
+				SkinColor:   "fair",
+				EyeColor:    "blue",
+				BirthYear:   "19BBY",
+				Gender:      "male",
+				Create:      "2014-12-09",
+				Edited:      "2014-12-20",
+				Films:       []string{"https://swapi.dev/api/films/1/", "https://swapi.dev/api/films/2/"},
+				FilmIDs:     []string{"1", "2"},
+				Homeworld:   "https://swapi.dev/api/planets/1/",
+				HomeworldID: "1",
+				Species:     []string{},
+				SpeciesIDs:  []string{},
+				Vehicles:    []string{"https://swapi.dev/api/vehicles/14/"},
+				VehicleIDs:  []string{"14"},
+				Starships:   []string{"https://swapi.dev/api/starships/12/"},
+				StarshipIDs: []string{"12"},
+			},
+		},
+		{
+			name: "unknown and n/a values are explicit",
+			dto: PersonDTO{
+				Name:      "IG-88",
+				Height:    "unknown",
+				Mass:      "n/a",
+				HairColor: "none",
+				SkinColor: "metal",
+				EyeColor:  "Unknown",
+				BirthYear: "",
+				Gender:    "N/A",
+				Created:   "2014-12-15T12:51:10.076000Z",
+				Edited:    "2014-12-20T21:17:50.351000Z",
+			},
+			want: domain.Person{
+				Name:        "IG-88",
+				HairColor:   "none",
+				SkinColor:   "metal",
+				EyeColor:    domain.AttributeUnknown,
+				BirthYear:   domain.AttributeUnknown,
+				Gender:      domain.AttributeNotApplicable,
+				Create:      "2014-12-15",
+				Edited:      "2014-12-20",
+				FilmIDs:     []string{},
+				SpeciesIDs:  []string{},
+				VehicleIDs:  []string{},
+				StarshipIDs: []string{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MapPersonDTOToDomain(tt.dto))
+		})
+	}
+}
+
+func TestMapPlanetDTOToDomain(t *testing.T) {
+	tests := []struct {
+		name string
+		dto  PlanetDTO
+		want domain.Planet
+	}{
+		{
+			name: "all attributes known",
+			dto: PlanetDTO{
+				Name:           "Tatooine",
+				RotationPeriod: "23",
+				OrbitalPeriod:  "304",
+				Diameter:       "10465",
+				Climate:        "arid",
+				Gravity:        "1 standard",
+				Terrain:        "desert",
+				SurfaceWater:   "1",
+				Population:     "200000",
+				Residents:      []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/"},
+				Created:        "2014-12-09T13:50:49.641000Z",
+				Films:          []string{"https://swapi.dev/api/films/1/"},
+				URL:            "https://swapi.dev/api/planets/1/",
+			},
+			want: domain.Planet{
+				ID:             "1",
+				Name:           "Tatooine",
+				RotationPeriod: domain.Measure(23),
+				OrbitalPeriod:  domain.Measure(304),
+				Diameter:       domain.Measure(10465),
+				Gravity:        "1 standard",
+				Population:     domain.Measure(200000),
+				SurfaceWater:   domain.Measure(1),
+				Climate:        []string{"arid"},
+				Terrain:        []string{"desert"},
+				Resident:       []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/"},
+				ResidentIDs:    []string{"1", "2"},
+				Created:        "2014-12-09",
+				Films:          []string{"https://swapi.dev/api/films/1/"},
+				FilmIDs:        []string{"1"},
+			},
+		},
+		{
+			name: "comma-separated lists and unknown numbers",
+			dto: PlanetDTO{
+				Name:           "Coruscant",
+				RotationPeriod: "unknown",
+				OrbitalPeriod:  "368",
+				Diameter:       "12240",
+				Climate:        "temperate, tropical",
+				Gravity:        "unknown",
+				Terrain:        "cityscape, mountains",
+				SurfaceWater:   "unknown",
+				Population:     "1000000000000",
+				Created:        "2014-12-10T11:54:13.921000Z",
+			},
+			want: domain.Planet{
+				Name:          "Coruscant",
+				OrbitalPeriod: domain.Measure(368),
+				Diameter:      domain.Measure(12240),
+				Gravity:       domain.AttributeUnknown,
+				Population:    domain.Measure(1000000000000),
+				Climate:       []string{"temperate", "tropical"},
+				Terrain:       []string{"cityscape", "mountains"},
+				ResidentIDs:   []string{},
+				Created:       "2014-12-10",
+				FilmIDs:       []string{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MapPlanetDTOToDomain(tt.dto))
+		})
+	}
+}
+
+func TestMapFilmDTOToDomain(t *testing.T) {
+	dto := FilmDTO{
+		Title:       "A New Hope",
+		EpisodeID:   4,
+		Director:    "George Lucas",
+		Producer:    "Gary Kurtz, Rick McCallum",
+		ReleaseDate: "1977-05-25",
+		Created:     "2014-12-10T14:23:31.880000Z",
+		Characters:  []string{"https://swapi.dev/api/people/1/"},
+	}
+
+	result := MapFilmDTOToDomain(dto)
+
+	assert.Equal(t, "A New Hope", result.Title)
+	assert.Equal(t, 4, result.EpisodeID)
+	assert.Equal(t, "George Lucas", result.Director)
+	assert.Equal(t, "1977-05-25", result.ReleaseDate)
+	assert.Equal(t, "2014-12-10", result.Created)
+	assert.Equal(t, dto.Characters, result.Characters)
+	assert.Equal(t, "A New Hope", result.GetName(), "films sort by title via Sortable")
+}
+
+func TestMapStarshipDTOToDomain(t *testing.T) {
+	dto := StarshipDTO{
+		Name:                 "Death Star",
+		CostInCredits:        "1000000000000",
+		Length:               "120000",
+		MaxAtmospheringSpeed: "n/a",
+		CargoCapacity:        "1,000,000,000,000",
+		HyperdriveRating:     "4.0",
+		MGLT:                 "10",
+		Created:              "2014-12-10T16:36:50.509000Z",
+	}
+
+	result := MapStarshipDTOToDomain(dto)
+
+	assert.Equal(t, domain.Measure(1000000000000), result.CostInCredits)
+	assert.Equal(t, domain.Measure(120000), result.Length)
+	assert.Equal(t, domain.Measurement{}, result.MaxAtmospheringSpeed, "n/a should be unknown")
+	assert.Equal(t, domain.Measure(1000000000000), result.CargoCapacity)
+	assert.Equal(t, domain.Measure(4), result.HyperdriveRating)
+	assert.Equal(t, domain.Measure(10), result.MGLT)
+	assert.Equal(t, "2014-12-10", result.Created)
+}
+
+func TestMapVehicleDTOToDomain(t *testing.T) {
+	dto := VehicleDTO{
+		Name:                 "Sand Crawler",
+		CostInCredits:        "unknown",
+		Length:               "36.8 ",
+		MaxAtmospheringSpeed: "30",
+		VehicleClass:         "wheeled",
+	}
+
+	result := MapVehicleDTOToDomain(dto)
+
+	assert.Equal(t, domain.Measurement{}, result.CostInCredits, "unknown should be unknown")
+	assert.Equal(t, domain.Measure(36.8), result.Length)
+	assert.Equal(t, domain.Measure(30), result.MaxAtmospheringSpeed)
+	assert.Equal(t, "wheeled", result.VehicleClass)
+}
+
+func TestMapSpeciesDTOToDomain(t *testing.T) {
+	homeworld := "https://swapi.dev/api/planets/14/"
+
+	tests := []struct {
+		name          string
+		dto           SpeciesDTO
+		wantHeight    domain.Measurement
+		wantLifespan  domain.Measurement
+		wantHomeworld string
+	}{
+		{
+			name:          "numeric averages",
+			dto:           SpeciesDTO{Name: "Wookie", AverageHeight: "210", AverageLifespan: "400", Homeworld: &homeworld},
+			wantHeight:    domain.Measure(210),
+			wantLifespan:  domain.Measure(400),
+			wantHomeworld: homeworld,
+		},
+		{
+			name:         "indefinite lifespan and null homeworld",
+			dto:          SpeciesDTO{Name: "Droid", AverageHeight: "n/a", AverageLifespan: "indefinite", Homeworld: nil},
+			wantHeight:   domain.Measurement{},
+			wantLifespan: domain.Measurement{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MapSpeciesDTOToDomain(tt.dto)
+
+			assert.Equal(t, tt.dto.Name, result.Name)
+			assert.Equal(t, tt.wantHeight, result.AverageHeight)
+			assert.Equal(t, tt.wantLifespan, result.AverageLifespan)
+			assert.Equal(t, tt.wantHomeworld, result.Homeworld)
+		})
+	}
+}
+
+func TestMappers_ResourceIDs(t *testing.T) {
+	film := MapFilmDTOToDomain(FilmDTO{
+		URL:        "https://swapi.dev/api/films/1/",
+		Characters: []string{"https://swapi.dev/api/people/1/", "https://swapi.dev/api/people/2/"},
+		Planets:    []string{"https://swapi.dev/api/planets/1/"},
+	})
+	assert.Equal(t, "1", film.ID)
+	assert.Equal(t, []string{"1", "2"}, film.CharacterIDs)
+	assert.Equal(t, []string{"1"}, film.PlanetIDs)
+
+	starship := MapStarshipDTOToDomain(StarshipDTO{
+		URL:    "https://swapi.dev/api/starships/10/",
+		Pilots: []string{"https://swapi.dev/api/people/13/", "https://swapi.dev/api/people/14/"},
+		Films:  []string{"https://swapi.dev/api/films/1/"},
+	})
+	assert.Equal(t, "10", starship.ID)
+	assert.Equal(t, []string{"13", "14"}, starship.PilotIDs)
+	assert.Equal(t, []string{"1"}, starship.FilmIDs)
+
+	vehicle := MapVehicleDTOToDomain(VehicleDTO{URL: "https://swapi.dev/api/vehicles/4/"})
+	assert.Equal(t, "4", vehicle.ID)
+	assert.Empty(t, vehicle.PilotIDs)
+
+	homeworld := "https://swapi.dev/api/planets/14/"
+	species := MapSpeciesDTOToDomain(SpeciesDTO{
+		URL:       "https://swapi.dev/api/species/3/",
+		Homeworld: &homeworld,
+		People:    []string{"https://swapi.dev/api/people/13/", "not-a-url"},
+	})
+	assert.Equal(t, "3", species.ID)
+	assert.Equal(t, "14", species.HomeworldID)
+	assert.Equal(t, []string{"13"}, species.PeopleIDs) // URLs without an ID are skipped
+
+	noHomeworld := MapSpeciesDTOToDomain(SpeciesDTO{URL: "https://swapi.dev/api/species/2/"})
+	assert.Empty(t, noHomeworld.HomeworldID)
+}
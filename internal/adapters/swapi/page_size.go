@@ -0,0 +1,31 @@
+package swapi
+
+import "sync"
+
+// pageSizeCache remembers how many items each SWAPI list endpoint returns per page.
+// Sizes are learned from upstream responses instead of assumed, so mirrors that
+// page differently are aggregated correctly.
+type pageSizeCache struct {
+	mu    sync.RWMutex
+	sizes map[string]int // Endpoint -> items on a full page
+}
+
+// get returns the cached page size of endpoint, if it has been detected yet.
+func (p *pageSizeCache) get(endpoint string) (int, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	size, ok := p.sizes[endpoint]
+	return size, ok
+}
+
+// set records the page size of endpoint.
+func (p *pageSizeCache) set(endpoint string, size int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.sizes == nil {
+		p.sizes = make(map[string]int)
+	}
+	p.sizes[endpoint] = size
+}
@@ -1,6 +1,9 @@
 package swapi
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+)
 
 // BuildURL constructs a SWAPI URL with endpoint, page, and optional search
 func BuildURL(baseURL, endpoint string, page int, search string) string {
@@ -10,3 +13,9 @@ func BuildURL(baseURL, endpoint string, page int, search string) string {
 	}
 	return url
 }
+
+// BuildResourceURL constructs a SWAPI URL for a single resource.
+// The id is path-escaped so it cannot alter the upstream path.
+func BuildResourceURL(baseURL, endpoint, id string) string {
+	return fmt.Sprintf("%s/%s/%s/", baseURL, endpoint, url.PathEscape(id))
+}
@@ -1,26 +1,35 @@
 package config
 
 import (
+	"log"
 	"os"
 	"strconv"
+	"time"
 )
 
 // Config holds application configuration.
 type Config struct {
-	Server ServerConfig
-	SWAPI  SWAPIConfig
-	CORS   CORSConfig
+	Server  ServerConfig
+	SWAPI   SWAPIConfig
+	CORS    CORSConfig
+	Expand  ExpandConfig
+	List    ListConfig
+	Suggest SuggestConfig
 }
 
 // ServerConfig holds server-related configuration.
 type ServerConfig struct {
-	Port string
+	Port                  string
+	TrustForwardedHeaders bool // Build absolute links from X-Forwarded-Proto/Host; enable only behind an ingress that sets them
 }
 
 // SWAPIConfig holds SWAPI-related configuration.
 type SWAPIConfig struct {
-	BaseURL  string
-	PageSize int // Number of items per page to return to clients
+	BaseURL          string
+	PageSize         int // Number of items per page to return to clients by default
+	MinPageSize      int // Smallest page size a client may request via ?pageSize= or ?limit=
+	MaxPageSize      int // Largest page size a client may request via ?pageSize= or ?limit=
+	FetchConcurrency int // Maximum SWAPI pages fetched in parallel to assemble one page
 }
 
 // CORSConfig holds CORS-related configuration.
@@ -28,19 +37,73 @@ type CORSConfig struct {
 	AllowedOrigins string // Comma-separated list of allowed origins, or "*" for all
 }
 
+// ExpandConfig holds configuration for ?expand= relationship resolution.
+type ExpandConfig struct {
+	MaxFetches  int // Maximum distinct upstream calls a single request may trigger
+	Concurrency int // Maximum upstream calls in flight per request
+}
+
+// ListConfig holds configuration for list endpoints.
+type ListConfig struct {
+	DefaultMode   string        // "page" or "collection" when the request has no ?mode=
+	CollectionTTL time.Duration // How long a fetched complete collection is reused
+	CursorSecret  string        // HMAC key for cursor tokens; empty uses a random key per process
+}
+
+// SuggestConfig holds configuration for the /api/suggest name index.
+type SuggestConfig struct {
+	RefreshInterval time.Duration // How often the index is rebuilt from SWAPI
+}
+
 // Load loads configuration from environment variables with defaults.
 func Load() *Config {
-	return &Config{
+	cfg := &Config{
 		Server: ServerConfig{
-			Port: getEnv("SERVER_PORT", ":6969"),
+			Port:                  getEnv("SERVER_PORT", ":6969"),
+			TrustForwardedHeaders: getEnvAsBool("SERVER_TRUST_FORWARDED_HEADERS", false),
 		},
 		SWAPI: SWAPIConfig{
-			BaseURL:  getEnv("SWAPI_BASE_URL", "https://swapi.dev/api"),
-			PageSize: getEnvAsInt("SWAPI_PAGE_SIZE", 15),
+			BaseURL:          getEnv("SWAPI_BASE_URL", "https://swapi.dev/api"),
+			PageSize:         getEnvAsInt("SWAPI_PAGE_SIZE", 15),
+			MinPageSize:      getEnvAsInt("SWAPI_MIN_PAGE_SIZE", 1),
+			MaxPageSize:      getEnvAsInt("SWAPI_MAX_PAGE_SIZE", 100),
+			FetchConcurrency: getEnvAsInt("SWAPI_FETCH_CONCURRENCY", 4),
 		},
 		CORS: CORSConfig{
 			AllowedOrigins: getEnv("CORS_ALLOWED_ORIGINS", "*"),
 		},
+		Expand: ExpandConfig{
+			MaxFetches:  getEnvAsInt("EXPAND_MAX_FETCHES", 50),
+			Concurrency: getEnvAsInt("EXPAND_CONCURRENCY", 5),
+		},
+		List: ListConfig{
+			DefaultMode:   getEnv("LIST_DEFAULT_MODE", "page"),
+			CollectionTTL: getEnvAsDuration("LIST_COLLECTION_TTL", 10*time.Minute),
+			CursorSecret:  getEnv("LIST_CURSOR_SECRET", ""),
+		},
+		Suggest: SuggestConfig{
+			RefreshInterval: getEnvAsDuration("SUGGEST_REFRESH_INTERVAL", time.Hour),
+		},
+	}
+
+	cfg.SWAPI.clampPageSizes()
+	return cfg
+}
+
+// clampPageSizes enforces 1 <= MinPageSize <= PageSize <= MaxPageSize, so the default
+// page size is always one a client could also request explicitly. Adjustments are logged.
+func (c *SWAPIConfig) clampPageSizes() {
+	if c.MinPageSize < 1 {
+		log.Printf("config: SWAPI_MIN_PAGE_SIZE=%d is below 1, using 1", c.MinPageSize)
+		c.MinPageSize = 1
+	}
+	if c.MaxPageSize < c.MinPageSize {
+		log.Printf("config: SWAPI_MAX_PAGE_SIZE=%d is below SWAPI_MIN_PAGE_SIZE, using %d", c.MaxPageSize, c.MinPageSize)
+		c.MaxPageSize = c.MinPageSize
+	}
+	if clamped := min(max(c.PageSize, c.MinPageSize), c.MaxPageSize); clamped != c.PageSize {
+		log.Printf("config: SWAPI_PAGE_SIZE=%d is outside %d-%d, using %d", c.PageSize, c.MinPageSize, c.MaxPageSize, clamped)
+		c.PageSize = clamped
 	}
 }
 
@@ -61,3 +124,23 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+// getEnvAsBool gets environment variable as bool (e.g. "true", "0") or returns default value.
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsDuration gets environment variable as time.Duration (e.g. "5m") or returns default value.
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
@@ -0,0 +1,47 @@
+package config
+
+import "testing"
+
+func TestSWAPIConfig_ClampPageSizes(t *testing.T) {
+	tests := []struct {
+		name string
+		in   SWAPIConfig
+		want SWAPIConfig
+	}{
+		{
+			name: "valid sizes are kept",
+			in:   SWAPIConfig{PageSize: 15, MinPageSize: 1, MaxPageSize: 100},
+			want: SWAPIConfig{PageSize: 15, MinPageSize: 1, MaxPageSize: 100},
+		},
+		{
+			name: "default above maximum",
+			in:   SWAPIConfig{PageSize: 200, MinPageSize: 1, MaxPageSize: 100},
+			want: SWAPIConfig{PageSize: 100, MinPageSize: 1, MaxPageSize: 100},
+		},
+		{
+			name: "default below minimum",
+			in:   SWAPIConfig{PageSize: 5, MinPageSize: 10, MaxPageSize: 100},
+			want: SWAPIConfig{PageSize: 10, MinPageSize: 10, MaxPageSize: 100},
+		},
+		{
+			name: "non-positive minimum",
+			in:   SWAPIConfig{PageSize: 15, MinPageSize: 0, MaxPageSize: 100},
+			want: SWAPIConfig{PageSize: 15, MinPageSize: 1, MaxPageSize: 100},
+		},
+		{
+			name: "maximum below minimum",
+			in:   SWAPIConfig{PageSize: 15, MinPageSize: 20, MaxPageSize: 10},
+			want: SWAPIConfig{PageSize: 20, MinPageSize: 20, MaxPageSize: 20},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.in
+			got.clampPageSizes()
+			if got != tt.want {
+				t.Errorf("clampPageSizes() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
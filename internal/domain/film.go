@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// Film represents a Star Wars film
+// @name Film
+type Film struct {
+	ID           string   `json:"id" example:"1"` // Parsed from the SWAPI url field
+	Title        string   `json:"title" example:"A New Hope"`
+	EpisodeID    int      `json:"episodeId" example:"4"`
+	OpeningCrawl string   `json:"openingCrawl" example:"It is a period of civil war..."`
+	Director     string   `json:"director" example:"George Lucas"`
+	Producer     string   `json:"producer" example:"Gary Kurtz, Rick McCallum"`
+	ReleaseDate  string   `json:"releaseDate" example:"1977-05-25"`
+	Characters   []string `json:"characters" example:"https://swapi.dev/api/people/1/"`
+	CharacterIDs []string `json:"characterIds" example:"1,2"`
+	Planets      []string `json:"planets" example:"https://swapi.dev/api/planets/1/"`
+	PlanetIDs    []string `json:"planetIds" example:"1,2"`
+	Created      string   `json:"created" example:"2014-12-10"`
+}
+
+// GetName returns the film's title (implements sorting.Sortable).
+func (f Film) GetName() string {
+	return f.Title
+}
+
+// GetCreated returns the creation time (implements sorting.Sortable).
+func (f Film) GetCreated() time.Time {
+	// Parse the date string for sorting purposes
+	t, _ := time.Parse("2006-01-02", f.Created)
+	return t
+}
+
+// GetReleaseDate returns the theatrical release date.
+func (f Film) GetReleaseDate() time.Time {
+	t, _ := time.Parse("2006-01-02", f.ReleaseDate)
+	return t
+}
@@ -0,0 +1,86 @@
+package domain
+
+import "time"
+
+// ListMode selects how a list endpoint applies search and sorting.
+type ListMode string
+
+const (
+	// ListModePage filters and sorts only the items of the requested upstream page.
+	ListModePage ListMode = "page"
+	// ListModeCollection fetches the complete collection, then filters, sorts and paginates it.
+	ListModeCollection ListMode = "collection"
+)
+
+// MatchMode selects how the search term is matched against names.
+type MatchMode string
+
+const (
+	// MatchContains keeps names containing the search term (case-insensitive).
+	MatchContains MatchMode = "contains"
+	// MatchFuzzy keeps names similar to the search term and scores them by relevance.
+	MatchFuzzy MatchMode = "fuzzy"
+)
+
+// NullsPlacement selects where items with an unknown sort value go, whatever the direction.
+type NullsPlacement string
+
+const (
+	// NullsFirst places unknown values before all known ones.
+	NullsFirst NullsPlacement = "first"
+	// NullsLast places unknown values after all known ones (the default).
+	NullsLast NullsPlacement = "last"
+)
+
+// ListQuery carries the client-controlled options of a list request.
+type ListQuery struct {
+	Page      int
+	PageSize  int // Items per page; 0 uses the service default
+	Offset    int // First item of a cursor-paginated page
+	Limit     int // Items per cursor-paginated page; > 0 replaces Page with Offset/Limit
+	Search    string
+	Match     MatchMode // Empty behaves like MatchContains
+	Threshold float64   // Minimum fuzzy relevance (0-1); 0 uses the default
+	SearchIn  []string  // Fields the search term is matched against; empty means name only
+	Filter    string    // Filter expression, e.g. "mass>80 and gender=male"
+	Ranges    RangeFilter
+	Sort      []SortKey // Applied in order; later keys break ties
+	Expand    []string
+	Mode      ListMode // Empty selects the service default
+}
+
+// NumberRange bounds a numeric field, e.g. mass between 50 and 100.
+// Both bounds are inclusive; a nil bound is open.
+type NumberRange struct {
+	Field string // JSON field name, e.g. "mass"
+	Min   *float64
+	Max   *float64
+}
+
+// DateRange bounds a date field, e.g. created between two days.
+// Both bounds are inclusive; a nil bound is open.
+type DateRange struct {
+	Field string // JSON field name, e.g. "created"
+	From  *time.Time
+	To    *time.Time
+}
+
+// RangeFilter holds the numeric and date ranges of a list request (?massMin=, ?createdFrom=, ...).
+type RangeFilter struct {
+	Numbers        []NumberRange
+	Dates          []DateRange
+	IncludeUnknown bool // Keep records whose ranged value is unknown instead of dropping them
+}
+
+// IsEmpty reports whether no range is set.
+func (r RangeFilter) IsEmpty() bool {
+	return len(r.Numbers) == 0 && len(r.Dates) == 0
+}
+
+// SortKey is one key of a multi-key sort, e.g. "mass:desc".
+type SortKey struct {
+	Field      string // JSON field name, e.g. "mass"
+	Descending bool
+	Nulls      NullsPlacement // Where unknown values go; empty behaves like NullsLast
+	Locale     string         // BCP 47 collation locale for text fields, e.g. "sv"; empty means "en"
+}
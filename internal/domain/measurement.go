@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// Measurement is a numeric attribute SWAPI may report as "unknown", "n/a" or similar,
+// e.g. a person's mass. The zero value is unknown. Unknown measurements serialize as
+// JSON null, so they can never be mistaken for a genuine 0.
+type Measurement struct {
+	Value float64 // Meaningful only when Known
+	Known bool
+}
+
+// Measure returns a known measurement of value.
+func Measure(value float64) Measurement {
+	return Measurement{Value: value, Known: true}
+}
+
+// MarshalJSON encodes the value as a JSON number, or null when it is unknown.
+func (m Measurement) MarshalJSON() ([]byte, error) {
+	if !m.Known {
+		return []byte("null"), nil
+	}
+	return strconv.AppendFloat(nil, m.Value, 'f', -1, 64), nil
+}
+
+// UnmarshalJSON decodes a JSON number, or null as an unknown measurement.
+func (m *Measurement) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*m = Measurement{}
+		return nil
+	}
+
+	var value float64
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*m = Measure(value)
+	return nil
+}
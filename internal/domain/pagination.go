@@ -1,8 +1,17 @@
 package domain
 
 type PaginatedResponse[T any] struct {
-	Count    int `json:"count"`
-	Page     int `json:"page"`
-	PageSize int `json:"pageSize"`
-	Results  []T `json:"results"`
+	Count       int    `json:"count"`
+	Page        int    `json:"page,omitempty"` // Omitted for cursor pagination
+	PageSize    int    `json:"pageSize"`
+	TotalPages  int    `json:"totalPages"`
+	HasNext     bool   `json:"hasNext"`
+	HasPrevious bool   `json:"hasPrevious"`
+	Results     []T    `json:"results"`
+	NextCursor  string `json:"nextCursor,omitempty"` // Cursor of the following page, if any
+	PrevCursor  string `json:"prevCursor,omitempty"` // Cursor of the preceding page, if any
+	First       string `json:"first,omitempty"`      // Absolute URL of the first page
+	Previous    string `json:"previous,omitempty"`   // Absolute URL of the preceding page, if any
+	Next        string `json:"next,omitempty"`       // Absolute URL of the following page, if any
+	Last        string `json:"last,omitempty"`       // Absolute URL of the last page
 }
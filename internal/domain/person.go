@@ -2,13 +2,38 @@ package domain
 
 import "time"
 
+// Canonical values for person attributes SWAPI reports as missing.
+const (
+	AttributeUnknown       = "unknown" // SWAPI has no data (e.g. hair_color of an obscure alien)
+	AttributeNotApplicable = "n/a"     // The attribute does not apply (e.g. gender of a droid)
+)
+
 // Person represents a Star Wars character
 // @name Person
 type Person struct {
-	Name   string   `json:"name" example:"Luke Skywalker"`
-	Mass   int      `json:"mass" example:"77"`
-	Create string   `json:"created" example:"2014-12-09"`
-	Films  []string `json:"films" example:"https://swapi.dev/api/films/1/,https://swapi.dev/api/films/2/"`
+	ID          string           `json:"id" example:"1"` // Parsed from the SWAPI url field
+	Name        string           `json:"name" example:"Luke Skywalker"`
+	Height      Measurement      `json:"height" example:"172"` // Centimetres; null when unknown
+	Mass        Measurement      `json:"mass" example:"78.2"`  // Kilograms; null when unknown
+	HairColor   string           `json:"hairColor" example:"blond"`
+	SkinColor   string           `json:"skinColor" example:"fair"`
+	EyeColor    string           `json:"eyeColor" example:"blue"`
+	BirthYear   string           `json:"birthYear" example:"19BBY"`
+	Gender      string           `json:"gender" example:"male"`
+	Create      string           `json:"created" example:"2014-12-09"`
+	Edited      string           `json:"edited" example:"2014-12-20"`
+	Films       []string         `json:"films" example:"https://swapi.dev/api/films/1/,https://swapi.dev/api/films/2/"`
+	FilmIDs     []string         `json:"filmIds" example:"1,2"`
+	Homeworld   string           `json:"homeworld" example:"https://swapi.dev/api/planets/1/"`
+	HomeworldID string           `json:"homeworldId" example:"1"`
+	Species     []string         `json:"species" example:"https://swapi.dev/api/species/1/"`
+	SpeciesIDs  []string         `json:"speciesIds" example:"1"`
+	Vehicles    []string         `json:"vehicles" example:"https://swapi.dev/api/vehicles/14/"`
+	VehicleIDs  []string         `json:"vehicleIds" example:"14"`
+	Starships   []string         `json:"starships" example:"https://swapi.dev/api/starships/12/"`
+	StarshipIDs []string         `json:"starshipIds" example:"12"`
+	Relevance   float64          `json:"relevance,omitempty" example:"0.89"` // Fuzzy search score (0-1); set only with ?match=fuzzy
+	Expanded    *PersonRelations `json:"expanded,omitempty"`                 // Set only when ?expand= is requested
 }
 
 // GetName returns the person's name (implements sorting.Sortable).
@@ -22,3 +47,8 @@ func (p Person) GetCreated() time.Time {
 	t, _ := time.Parse("2006-01-02", p.Create)
 	return t
 }
+
+// GetRelevance returns the fuzzy search score (implements sorting.Scored).
+func (p Person) GetRelevance() float64 {
+	return p.Relevance
+}
@@ -2,11 +2,26 @@ package domain
 
 import "time"
 
+// Planet represents a Star Wars planet.
+// Unknown numeric attributes serialize as null.
 type Planet struct {
-	Name     string   `json:"name"`
-	Resident []string `json:"residents"`
-	Created  string   `json:"created"`
-	Films    []string `json:"films"`
+	ID             string           `json:"id"` // Parsed from the SWAPI url field
+	Name           string           `json:"name"`
+	RotationPeriod Measurement      `json:"rotationPeriod"` // Hours
+	OrbitalPeriod  Measurement      `json:"orbitalPeriod"`  // Days
+	Diameter       Measurement      `json:"diameter"`       // Kilometres
+	Gravity        string           `json:"gravity"`        // Free text, e.g. "1 standard"
+	Population     Measurement      `json:"population"`
+	SurfaceWater   Measurement      `json:"surfaceWater"` // Percentage
+	Climate        []string         `json:"climate"`
+	Terrain        []string         `json:"terrain"`
+	Resident       []string         `json:"residents"`
+	ResidentIDs    []string         `json:"residentIds"`
+	Created        string           `json:"created"`
+	Films          []string         `json:"films"`
+	FilmIDs        []string         `json:"filmIds"`
+	Relevance      float64          `json:"relevance,omitempty"` // Fuzzy search score (0-1); set only with ?match=fuzzy
+	Expanded       *PlanetRelations `json:"expanded,omitempty"`  // Set only when ?expand= is requested
 }
 
 // GetName returns the planet's name (implements sorting.Sortable).
@@ -20,3 +35,8 @@ func (p Planet) GetCreated() time.Time {
 	t, _ := time.Parse("2006-01-02", p.Created)
 	return t
 }
+
+// GetRelevance returns the fuzzy search score (implements sorting.Scored).
+func (p Planet) GetRelevance() float64 {
+	return p.Relevance
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PersonSummary is a lightweight view of a related person.
+// @name PersonSummary
+type PersonSummary struct {
+	URL  string `json:"url" example:"https://swapi.dev/api/people/1/"`
+	Name string `json:"name" example:"Luke Skywalker"`
+}
+
+// PlanetSummary is a lightweight view of a related planet.
+// @name PlanetSummary
+type PlanetSummary struct {
+	URL  string `json:"url" example:"https://swapi.dev/api/planets/1/"`
+	Name string `json:"name" example:"Tatooine"`
+}
+
+// FilmSummary is a lightweight view of a related film.
+// @name FilmSummary
+type FilmSummary struct {
+	URL         string `json:"url" example:"https://swapi.dev/api/films/1/"`
+	Title       string `json:"title" example:"A New Hope"`
+	EpisodeID   int    `json:"episodeId" example:"4"`
+	ReleaseDate string `json:"releaseDate" example:"1977-05-25"`
+}
+
+// PersonRelations holds related resources resolved via ?expand= on people.
+// @name PersonRelations
+type PersonRelations struct {
+	Films     []FilmSummary  `json:"films,omitempty"`
+	Homeworld *PlanetSummary `json:"homeworld,omitempty"`
+}
+
+// PlanetRelations holds related resources resolved via ?expand= on planets.
+// @name PlanetRelations
+type PlanetRelations struct {
+	Residents []PersonSummary `json:"residents,omitempty"`
+	Films     []FilmSummary   `json:"films,omitempty"`
+}
+
+// ResourceIDFromURL extracts the numeric ID from a SWAPI resource URL.
+// Example: "https://swapi.dev/api/films/1/" -> "1", true
+func ResourceIDFromURL(url string) (string, bool) {
+	trimmed := strings.TrimSuffix(url, "/")
+	idx := strings.LastIndex(trimmed, "/")
+	if idx < 0 {
+		return "", false
+	}
+
+	id := trimmed[idx+1:]
+	if n, err := strconv.Atoi(id); err != nil || n <= 0 {
+		return "", false
+	}
+
+	return id, true
+}
+
+// ResourceIDsFromURLs extracts the IDs from a list of SWAPI resource URLs,
+// preserving order and skipping URLs without an ID.
+func ResourceIDsFromURLs(urls []string) []string {
+	ids := make([]string, 0, len(urls))
+	for _, url := range urls {
+		if id, ok := ResourceIDFromURL(url); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// Species represents a Star Wars species
+// @name Species
+type Species struct {
+	ID              string      `json:"id" example:"1"` // Parsed from the SWAPI url field
+	Name            string      `json:"name" example:"Wookie"`
+	Classification  string      `json:"classification" example:"mammal"`
+	Designation     string      `json:"designation" example:"sentient"`
+	AverageHeight   Measurement `json:"averageHeight" example:"210"`
+	AverageLifespan Measurement `json:"averageLifespan" example:"400"`
+	SkinColors      string      `json:"skinColors" example:"gray"`
+	HairColors      string      `json:"hairColors" example:"black, brown"`
+	EyeColors       string      `json:"eyeColors" example:"blue, green, yellow, brown, golden, red"`
+	Homeworld       string      `json:"homeworld" example:"https://swapi.dev/api/planets/14/"`
+	HomeworldID     string      `json:"homeworldId" example:"14"`
+	Language        string      `json:"language" example:"Shyriiwook"`
+	People          []string    `json:"people" example:"https://swapi.dev/api/people/13/"`
+	PeopleIDs       []string    `json:"peopleIds" example:"13"`
+	Films           []string    `json:"films" example:"https://swapi.dev/api/films/1/"`
+	FilmIDs         []string    `json:"filmIds" example:"1"`
+	Created         string      `json:"created" example:"2014-12-10"`
+}
+
+// SpeciesFilter holds exact-match attribute filters for species.
+// Empty fields are ignored.
+type SpeciesFilter struct {
+	Classification string
+	Designation    string
+	Language       string
+}
+
+// GetName returns the species' name (implements sorting.Sortable).
+func (s Species) GetName() string {
+	return s.Name
+}
+
+// GetCreated returns the creation time (implements sorting.Sortable).
+func (s Species) GetCreated() time.Time {
+	// Parse the date string for sorting purposes
+	t, _ := time.Parse("2006-01-02", s.Created)
+	return t
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// Starship represents a Star Wars starship
+// @name Starship
+type Starship struct {
+	ID                   string      `json:"id" example:"1"` // Parsed from the SWAPI url field
+	Name                 string      `json:"name" example:"Millennium Falcon"`
+	Model                string      `json:"model" example:"YT-1300 light freighter"`
+	Manufacturer         string      `json:"manufacturer" example:"Corellian Engineering Corporation"`
+	CostInCredits        Measurement `json:"costInCredits" example:"100000"`
+	Length               Measurement `json:"length" example:"34.37"`
+	MaxAtmospheringSpeed Measurement `json:"maxAtmospheringSpeed" example:"1050"`
+	Crew                 string      `json:"crew" example:"4"`
+	Passengers           string      `json:"passengers" example:"6"`
+	CargoCapacity        Measurement `json:"cargoCapacity" example:"100000"`
+	Consumables          string      `json:"consumables" example:"2 months"`
+	HyperdriveRating     Measurement `json:"hyperdriveRating" example:"0.5"`
+	MGLT                 Measurement `json:"mglt" example:"75"`
+	StarshipClass        string      `json:"starshipClass" example:"Light freighter"`
+	Pilots               []string    `json:"pilots" example:"https://swapi.dev/api/people/13/"`
+	PilotIDs             []string    `json:"pilotIds" example:"13"`
+	Films                []string    `json:"films" example:"https://swapi.dev/api/films/1/"`
+	FilmIDs              []string    `json:"filmIds" example:"1"`
+	Created              string      `json:"created" example:"2014-12-10"`
+}
+
+// GetName returns the starship's name (implements sorting.Sortable).
+func (s Starship) GetName() string {
+	return s.Name
+}
+
+// GetCreated returns the creation time (implements sorting.Sortable).
+func (s Starship) GetCreated() time.Time {
+	// Parse the date string for sorting purposes
+	t, _ := time.Parse("2006-01-02", s.Created)
+	return t
+}
+
+// GetCostInCredits returns the cost in galactic credits (implements sorting.Craft).
+func (s Starship) GetCostInCredits() Measurement {
+	return s.CostInCredits
+}
+
+// GetLength returns the length in meters (implements sorting.Craft).
+func (s Starship) GetLength() Measurement {
+	return s.Length
+}
+
+// GetMaxAtmospheringSpeed returns the maximum speed in atmosphere (implements sorting.Craft).
+func (s Starship) GetMaxAtmospheringSpeed() Measurement {
+	return s.MaxAtmospheringSpeed
+}
@@ -0,0 +1,9 @@
+package domain
+
+// Suggestion is a name completion for the search box.
+// Type and ID identify the resource, whose detail endpoint is /api/{type}/{id}.
+type Suggestion struct {
+	Type string `json:"type"` // Resource collection, e.g. "people" or "planets"
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+// Vehicle represents a Star Wars vehicle
+// @name Vehicle
+type Vehicle struct {
+	ID                   string      `json:"id" example:"1"` // Parsed from the SWAPI url field
+	Name                 string      `json:"name" example:"Sand Crawler"`
+	Model                string      `json:"model" example:"Digger Crawler"`
+	Manufacturer         string      `json:"manufacturer" example:"Corellia Mining Corporation"`
+	CostInCredits        Measurement `json:"costInCredits" example:"150000"`
+	Length               Measurement `json:"length" example:"36.8"`
+	MaxAtmospheringSpeed Measurement `json:"maxAtmospheringSpeed" example:"30"`
+	Crew                 string      `json:"crew" example:"46"`
+	Passengers           string      `json:"passengers" example:"30"`
+	CargoCapacity        Measurement `json:"cargoCapacity" example:"50000"`
+	Consumables          string      `json:"consumables" example:"2 months"`
+	VehicleClass         string      `json:"vehicleClass" example:"wheeled"`
+	Pilots               []string    `json:"pilots" example:"https://swapi.dev/api/people/1/"`
+	PilotIDs             []string    `json:"pilotIds" example:"1"`
+	Films                []string    `json:"films" example:"https://swapi.dev/api/films/1/"`
+	FilmIDs              []string    `json:"filmIds" example:"1"`
+	Created              string      `json:"created" example:"2014-12-10"`
+}
+
+// GetName returns the vehicle's name (implements sorting.Sortable).
+func (v Vehicle) GetName() string {
+	return v.Name
+}
+
+// GetCreated returns the creation time (implements sorting.Sortable).
+func (v Vehicle) GetCreated() time.Time {
+	// Parse the date string for sorting purposes
+	t, _ := time.Parse("2006-01-02", v.Created)
+	return t
+}
+
+// GetCostInCredits returns the cost in galactic credits (implements sorting.Craft).
+func (v Vehicle) GetCostInCredits() Measurement {
+	return v.CostInCredits
+}
+
+// GetLength returns the length in meters (implements sorting.Craft).
+func (v Vehicle) GetLength() Measurement {
+	return v.Length
+}
+
+// GetMaxAtmospheringSpeed returns the maximum speed in atmosphere (implements sorting.Craft).
+func (v Vehicle) GetMaxAtmospheringSpeed() Measurement {
+	return v.MaxAtmospheringSpeed
+}
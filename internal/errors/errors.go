@@ -28,6 +28,34 @@ var (
 		Status:  404,
 	}
 
+	// ErrFilmNotFound indicates a film was not found in SWAPI
+	ErrFilmNotFound = APIError{
+		Code:    "FILM_NOT_FOUND",
+		Message: "Film not found",
+		Status:  404,
+	}
+
+	// ErrStarshipNotFound indicates a starship was not found in SWAPI
+	ErrStarshipNotFound = APIError{
+		Code:    "STARSHIP_NOT_FOUND",
+		Message: "Starship not found",
+		Status:  404,
+	}
+
+	// ErrVehicleNotFound indicates a vehicle was not found in SWAPI
+	ErrVehicleNotFound = APIError{
+		Code:    "VEHICLE_NOT_FOUND",
+		Message: "Vehicle not found",
+		Status:  404,
+	}
+
+	// ErrSpeciesNotFound indicates a species was not found in SWAPI
+	ErrSpeciesNotFound = APIError{
+		Code:    "SPECIES_NOT_FOUND",
+		Message: "Species not found",
+		Status:  404,
+	}
+
 	// ErrInvalidSortField indicates an invalid sort field was provided
 	ErrInvalidSortField = APIError{
 		Code:    "INVALID_SORT_FIELD",
@@ -49,6 +77,13 @@ var (
 		Status:  400,
 	}
 
+	// ErrExpansionLimitExceeded indicates ?expand= would trigger too many upstream calls
+	ErrExpansionLimitExceeded = APIError{
+		Code:    "EXPANSION_LIMIT_EXCEEDED",
+		Message: "Too many related resources to expand, request fewer relations or a smaller page",
+		Status:  400,
+	}
+
 	// ErrSWAPIUnavailable indicates SWAPI service is unavailable
 	ErrSWAPIUnavailable = APIError{
 		Code:    "SWAPI_UNAVAILABLE",
@@ -7,7 +7,7 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
-// MockSwapiRepository is a mock implementation of ports.PeopleRepository
+// MockSwapiRepository is a mock implementation of the SWAPI repository ports
 type MockSwapiRepository struct {
 	mock.Mock
 }
@@ -18,8 +18,8 @@ func NewMockSwapiRepository() *MockSwapiRepository {
 }
 
 // APIRetrievePeople mocks fetching people with pagination
-func (m *MockSwapiRepository) APIRetrievePeople(ctx context.Context, page int, search string) (domain.PaginatedResponse[domain.Person], error) {
-	args := m.Called(ctx, page, search)
+func (m *MockSwapiRepository) APIRetrievePeople(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Person], error) {
+	args := m.Called(ctx, page, pageSize, search)
 	return args.Get(0).(domain.PaginatedResponse[domain.Person]), args.Error(1)
 }
 
@@ -28,3 +28,81 @@ func (m *MockSwapiRepository) APIRetrievePersonByID(ctx context.Context, id stri
 	args := m.Called(ctx, id)
 	return args.Get(0).(domain.Person), args.Error(1)
 }
+
+// APIRetrieveAllPeople mocks fetching the complete people collection
+func (m *MockSwapiRepository) APIRetrieveAllPeople(ctx context.Context) ([]domain.Person, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Person), args.Error(1)
+}
+
+// FetchPlanets mocks fetching planets with pagination
+func (m *MockSwapiRepository) FetchPlanets(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Planet], error) {
+	args := m.Called(ctx, page, pageSize, search)
+	return args.Get(0).(domain.PaginatedResponse[domain.Planet]), args.Error(1)
+}
+
+// FetchPlanetByID mocks fetching a single planet by ID
+func (m *MockSwapiRepository) FetchPlanetByID(ctx context.Context, id string) (domain.Planet, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Planet), args.Error(1)
+}
+
+// FetchAllPlanets mocks fetching the complete planets collection
+func (m *MockSwapiRepository) FetchAllPlanets(ctx context.Context) ([]domain.Planet, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Planet), args.Error(1)
+}
+
+// FetchFilms mocks fetching films with pagination
+func (m *MockSwapiRepository) FetchFilms(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Film], error) {
+	args := m.Called(ctx, page, pageSize, search)
+	return args.Get(0).(domain.PaginatedResponse[domain.Film]), args.Error(1)
+}
+
+// FetchAllFilms mocks fetching the complete films collection
+func (m *MockSwapiRepository) FetchAllFilms(ctx context.Context) ([]domain.Film, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Film), args.Error(1)
+}
+
+// FetchFilmByID mocks fetching a single film by ID
+func (m *MockSwapiRepository) FetchFilmByID(ctx context.Context, id string) (domain.Film, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Film), args.Error(1)
+}
+
+// FetchStarships mocks fetching starships with pagination
+func (m *MockSwapiRepository) FetchStarships(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Starship], error) {
+	args := m.Called(ctx, page, pageSize, search)
+	return args.Get(0).(domain.PaginatedResponse[domain.Starship]), args.Error(1)
+}
+
+// FetchStarshipByID mocks fetching a single starship by ID
+func (m *MockSwapiRepository) FetchStarshipByID(ctx context.Context, id string) (domain.Starship, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Starship), args.Error(1)
+}
+
+// FetchVehicles mocks fetching vehicles with pagination
+func (m *MockSwapiRepository) FetchVehicles(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Vehicle], error) {
+	args := m.Called(ctx, page, pageSize, search)
+	return args.Get(0).(domain.PaginatedResponse[domain.Vehicle]), args.Error(1)
+}
+
+// FetchVehicleByID mocks fetching a single vehicle by ID
+func (m *MockSwapiRepository) FetchVehicleByID(ctx context.Context, id string) (domain.Vehicle, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Vehicle), args.Error(1)
+}
+
+// FetchAllSpecies mocks fetching the complete species collection
+func (m *MockSwapiRepository) FetchAllSpecies(ctx context.Context) ([]domain.Species, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]domain.Species), args.Error(1)
+}
+
+// FetchSpeciesByID mocks fetching a single species by ID
+func (m *MockSwapiRepository) FetchSpeciesByID(ctx context.Context, id string) (domain.Species, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(domain.Species), args.Error(1)
+}
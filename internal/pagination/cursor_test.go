@@ -0,0 +1,84 @@
+package pagination_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/pagination"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorCodec_RoundTrip(t *testing.T) {
+	codec := pagination.NewCursorCodec([]byte("secret"))
+	cursor := pagination.Cursor{Offset: 30, Limit: 15, Scope: "a", Filters: "b", Sort: "c"}
+
+	token := codec.Encode(cursor)
+	decoded, err := codec.Decode(token)
+
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestCursorCodec_Decode_Rejects(t *testing.T) {
+	codec := pagination.NewCursorCodec([]byte("secret"))
+	token := codec.Encode(pagination.Cursor{Offset: 30, Limit: 15})
+	payload, signature, _ := strings.Cut(token, ".")
+
+	// Re-sign an altered payload with another key
+	forged := pagination.NewCursorCodec([]byte("guess")).Encode(pagination.Cursor{Offset: 0, Limit: 100})
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"empty", ""},
+		{"no signature", payload},
+		{"signed with another key", forged},
+		{"payload swapped", forgedPayload + "." + signature},
+		{"signature not base64", payload + ".!!!"},
+		{"negative offset", codec.Encode(pagination.Cursor{Offset: -1, Limit: 15})},
+		{"zero limit", codec.Encode(pagination.Cursor{Offset: 0})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := codec.Decode(tt.token)
+			assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+		})
+	}
+}
+
+func TestCursorCodec_RandomSecret(t *testing.T) {
+	token := pagination.NewCursorCodec(nil).Encode(pagination.Cursor{Limit: 15})
+
+	_, err := pagination.NewCursorCodec(nil).Decode(token)
+	assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+}
+
+func TestWindow(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name   string
+		offset int
+		limit  int
+		want   []int
+	}{
+		{"first window", 0, 2, []int{1, 2}},
+		{"middle window", 2, 2, []int{3, 4}},
+		{"partial last window", 4, 2, []int{5}},
+		{"past the end", 10, 2, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pagination.Window(items, tt.offset, tt.limit)
+
+			assert.Equal(t, 5, got.Count)
+			assert.Equal(t, len(tt.want), got.PageSize)
+			assert.Equal(t, tt.want, got.Results)
+		})
+	}
+}
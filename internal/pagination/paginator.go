@@ -104,3 +104,34 @@ func BuildResponse[T any](items []T, totalCount int, strategy *AggregationStrate
 		Results:  results,
 	}
 }
+
+// Paginate builds a paginated response from a collection that is already fully in memory.
+// The collection is treated as a single external page holding every item, so the same
+// offset and slicing rules apply as for aggregated upstream pages.
+func Paginate[T any](items []T, page, pageSize int) domain.PaginatedResponse[T] {
+	externalPageSize := len(items)
+	if externalPageSize == 0 {
+		externalPageSize = 1
+	}
+
+	strategy := NewAggregationStrategy(page, pageSize, externalPageSize)
+	if startPage, _, _ := strategy.CalculatePageRange(); startPage > 1 {
+		// Requested page starts past the end of the collection
+		return BuildResponse([]T{}, len(items), strategy)
+	}
+
+	return BuildResponse(items, len(items), strategy)
+}
+
+// Window builds the response for the items at offset..offset+limit of a collection
+// that is already fully in memory. An offset past the end yields an empty page.
+func Window[T any](items []T, offset, limit int) domain.PaginatedResponse[T] {
+	start := min(offset, len(items))
+	end := min(start+limit, len(items))
+
+	return domain.PaginatedResponse[T]{
+		Count:    len(items),
+		PageSize: end - start,
+		Results:  items[start:end],
+	}
+}
@@ -177,3 +177,35 @@ func TestSliceResultsTyped(t *testing.T) {
 		})
 	}
 }
+
+func TestPaginate(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7}
+
+	tests := []struct {
+		name         string
+		items        []int
+		page         int
+		pageSize     int
+		wantResults  []int
+		wantPageSize int
+	}{
+		{name: "first page", items: items, page: 1, pageSize: 3, wantResults: []int{1, 2, 3}, wantPageSize: 3},
+		{name: "middle page", items: items, page: 2, pageSize: 3, wantResults: []int{4, 5, 6}, wantPageSize: 3},
+		{name: "partial last page", items: items, page: 3, pageSize: 3, wantResults: []int{7}, wantPageSize: 1},
+		{name: "page past the end", items: items, page: 4, pageSize: 3, wantResults: []int{}, wantPageSize: 0},
+		{name: "page size larger than collection", items: items, page: 1, pageSize: 15, wantResults: items, wantPageSize: 7},
+		{name: "second page when everything fits on the first", items: items, page: 2, pageSize: 15, wantResults: []int{}, wantPageSize: 0},
+		{name: "empty collection", items: []int{}, page: 1, pageSize: 3, wantResults: []int{}, wantPageSize: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := pagination.Paginate(tt.items, tt.page, tt.pageSize)
+
+			assert.Equal(t, len(tt.items), result.Count)
+			assert.Equal(t, tt.page, result.Page)
+			assert.Equal(t, tt.wantPageSize, result.PageSize)
+			assert.Equal(t, tt.wantResults, result.Results)
+		})
+	}
+}
@@ -8,12 +8,43 @@ import (
 
 // PeopleService - Interface for business logic
 type PeopleServiceInterface interface {
-	ListPeople(ctx context.Context, page int, search, sortBy, sortOrder string) (domain.PaginatedResponse[domain.Person], error)
-	GetPeopleByID(ctx context.Context, id string) (domain.Person, error)
+	ListPeople(ctx context.Context, q domain.ListQuery) (domain.PaginatedResponse[domain.Person], error)
+	GetPeopleByID(ctx context.Context, id string, expand []string) (domain.Person, error)
+	ListPersonFilms(ctx context.Context, personID string, q domain.ListQuery) (domain.PaginatedResponse[domain.Film], error)
 }
 
 // PlanetServiceInterface - Interface for planet business logic
 type PlanetServiceInterface interface {
-	ListPlanets(ctx context.Context, page int, searchTerm, sortBy, sortOrder string) (domain.PaginatedResponse[domain.Planet], error)
-	GetPlanetByID(ctx context.Context, id string) (domain.Planet, error)
+	ListPlanets(ctx context.Context, q domain.ListQuery) (domain.PaginatedResponse[domain.Planet], error)
+	GetPlanetByID(ctx context.Context, id string, expand []string) (domain.Planet, error)
+	ListPlanetResidents(ctx context.Context, planetID string, q domain.ListQuery) (domain.PaginatedResponse[domain.Person], error)
+}
+
+// FilmServiceInterface - Interface for film business logic
+type FilmServiceInterface interface {
+	ListFilms(ctx context.Context, page, pageSize int, searchTerm string, sort []domain.SortKey) (domain.PaginatedResponse[domain.Film], error)
+	GetFilmByID(ctx context.Context, id string) (domain.Film, error)
+}
+
+// StarshipServiceInterface - Interface for starship business logic
+type StarshipServiceInterface interface {
+	ListStarships(ctx context.Context, page, pageSize int, searchTerm string, sort []domain.SortKey) (domain.PaginatedResponse[domain.Starship], error)
+	GetStarshipByID(ctx context.Context, id string) (domain.Starship, error)
+}
+
+// VehicleServiceInterface - Interface for vehicle business logic
+type VehicleServiceInterface interface {
+	ListVehicles(ctx context.Context, page, pageSize int, searchTerm string, sort []domain.SortKey) (domain.PaginatedResponse[domain.Vehicle], error)
+	GetVehicleByID(ctx context.Context, id string) (domain.Vehicle, error)
+}
+
+// SpeciesServiceInterface - Interface for species business logic
+type SpeciesServiceInterface interface {
+	ListSpecies(ctx context.Context, page, pageSize int, searchTerm string, sort []domain.SortKey, filter domain.SpeciesFilter) (domain.PaginatedResponse[domain.Species], error)
+	GetSpeciesByID(ctx context.Context, id string) (domain.Species, error)
+}
+
+// SuggestServiceInterface - Interface for name completion
+type SuggestServiceInterface interface {
+	Suggest(ctx context.Context, q string, types []string, limit int) ([]domain.Suggestion, error)
 }
@@ -8,12 +8,40 @@ import (
 
 // PeopleRepository is a port for fetching people
 type PeopleRepository interface {
-	APIRetrievePeople(ctx context.Context, page int, search string) (domain.PaginatedResponse[domain.Person], error)
+	APIRetrievePeople(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Person], error)
 	APIRetrievePersonByID(ctx context.Context, id string) (domain.Person, error)
+	APIRetrieveAllPeople(ctx context.Context) ([]domain.Person, error)
 }
 
 // PlanetsRepository is a port for fetching planets
 type PlanetsRepository interface {
-	FetchPlanets(ctx context.Context, page int, search string) (domain.PaginatedResponse[domain.Planet], error)
+	FetchPlanets(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Planet], error)
 	FetchPlanetByID(ctx context.Context, id string) (domain.Planet, error)
+	FetchAllPlanets(ctx context.Context) ([]domain.Planet, error)
+}
+
+// FilmsRepository is a port for fetching films
+type FilmsRepository interface {
+	FetchFilms(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Film], error)
+	FetchFilmByID(ctx context.Context, id string) (domain.Film, error)
+	FetchAllFilms(ctx context.Context) ([]domain.Film, error)
+}
+
+// StarshipsRepository is a port for fetching starships
+type StarshipsRepository interface {
+	FetchStarships(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Starship], error)
+	FetchStarshipByID(ctx context.Context, id string) (domain.Starship, error)
+}
+
+// VehiclesRepository is a port for fetching vehicles
+type VehiclesRepository interface {
+	FetchVehicles(ctx context.Context, page, pageSize int, search string) (domain.PaginatedResponse[domain.Vehicle], error)
+	FetchVehicleByID(ctx context.Context, id string) (domain.Vehicle, error)
+}
+
+// SpeciesRepository is a port for fetching species.
+// Species lists are filtered in memory, so only the complete collection is fetched.
+type SpeciesRepository interface {
+	FetchSpeciesByID(ctx context.Context, id string) (domain.Species, error)
+	FetchAllSpecies(ctx context.Context) ([]domain.Species, error)
 }
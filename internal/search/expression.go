@@ -0,0 +1,309 @@
+package search
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Filter expression grammar (keywords are case-insensitive):
+//
+//	expression := or
+//	or         := and { "or" and }
+//	and        := unary { "and" unary }
+//	unary      := "not" unary | "(" expression ")" | comparison
+//	comparison := field operator value
+//	operator   := "=" | "!=" | ">" | ">=" | "<" | "<=" | "~"
+//	value      := word | "quoted string"
+//
+// Examples: `mass>80 and gender=male`, `created>=2014-12-10`, `films~"/films/1/"`
+
+// ParseError describes an invalid filter expression.
+// Pos is the 1-based character position of the offending token.
+type ParseError struct {
+	Pos     int
+	Token   string
+	Message string
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Pos)
+}
+
+// tokenKind classifies lexer tokens.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+)
+
+// token is a lexeme with its 1-based position in the expression.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// describe renders the token for error messages.
+func (t token) describe() string {
+	if t.kind == tokenEOF {
+		return "end of filter"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// isKeyword reports whether the token is the given keyword (and, or, not).
+func (t token) isKeyword(keyword string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, keyword)
+}
+
+// operators lists comparison operators, two-character ones first so ">=" wins over ">".
+var operators = []string{"!=", ">=", "<=", "=", ">", "<", "~"}
+
+// isWordRune reports whether r may appear in an unquoted field name or value.
+func isWordRune(r rune) bool {
+	return !unicode.IsSpace(r) && !strings.ContainsRune(`()"=!<>~`, r)
+}
+
+// tokenize splits a filter expression into tokens.
+func tokenize(input string) ([]token, error) {
+	runes := []rune(input)
+	var tokens []token
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		pos := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: pos})
+			i++
+
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: pos})
+			i++
+
+		case r == '"':
+			text, next, ok := readQuoted(runes, i)
+			if !ok {
+				return nil, &ParseError{Pos: pos, Token: string(runes[i:]), Message: "unterminated string"}
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: pos})
+			i = next
+
+		case strings.ContainsRune("=!<>~", r):
+			op := matchOperator(runes[i:])
+			if op == "" {
+				return nil, &ParseError{Pos: pos, Token: string(r), Message: fmt.Sprintf("unknown operator %q", string(r))}
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: op, pos: pos})
+			i += len(op)
+
+		default:
+			start := i
+			for i < len(runes) && isWordRune(runes[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: string(runes[start:i]), pos: pos})
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(runes) + 1}), nil
+}
+
+// readQuoted reads a double-quoted string starting at runes[start], handling \" and \\ escapes.
+// Returns the unquoted text and the index just past the closing quote.
+func readQuoted(runes []rune, start int) (string, int, bool) {
+	var b strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				b.WriteRune(runes[i])
+			}
+		case '"':
+			return b.String(), i + 1, true
+		default:
+			b.WriteRune(runes[i])
+		}
+	}
+	return "", 0, false
+}
+
+// matchOperator returns the longest operator at the start of runes, or "" if there is none.
+func matchOperator(runes []rune) string {
+	for _, op := range operators {
+		if len(runes) >= len(op) && string(runes[:len(op)]) == op {
+			return op
+		}
+	}
+	return ""
+}
+
+// node is a parsed filter expression.
+type node interface{}
+
+// logicalNode joins two expressions with "and" / "or".
+type logicalNode struct {
+	op          string
+	left, right node
+}
+
+// notNode negates an expression.
+type notNode struct {
+	operand node
+}
+
+// comparisonNode compares a field with a literal value.
+type comparisonNode struct {
+	field token
+	op    token
+	value token
+}
+
+// maxNestingDepth bounds how deeply "not" and parentheses may nest, so untrusted
+// filters cannot make the recursive-descent parser recurse without limit.
+const maxNestingDepth = 32
+
+// parser is a recursive-descent parser over the token stream.
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int // Current "not"/parenthesis nesting
+}
+
+// parseExpression parses a filter expression into a syntax tree.
+func parseExpression(input string) (node, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, p.unexpected(next, "expected \"and\", \"or\" or end of filter")
+	}
+
+	return expr, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) unexpected(t token, expected string) *ParseError {
+	return &ParseError{Pos: t.pos, Token: t.text, Message: fmt.Sprintf("unexpected %s, %s", t.describe(), expected)}
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().isKeyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalNode{op: "or", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().isKeyword("and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = logicalNode{op: "and", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	t := p.peek()
+
+	if t.isKeyword("not") || t.kind == tokenLParen {
+		p.depth++
+		defer func() { p.depth-- }()
+		if p.depth > maxNestingDepth {
+			return nil, &ParseError{Pos: t.pos, Token: t.text, Message: "expression nested too deeply"}
+		}
+	}
+
+	switch {
+	case t.isKeyword("not"):
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{operand: operand}, nil
+
+	case t.kind == tokenLParen:
+		p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, p.unexpected(closing, "expected \")\"")
+		}
+		return expr, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	field := p.next()
+	if field.kind != tokenWord {
+		return nil, p.unexpected(field, "expected a field name")
+	}
+
+	op := p.next()
+	if op.kind != tokenOperator {
+		return nil, p.unexpected(op, fmt.Sprintf("expected an operator after %q", field.text))
+	}
+
+	value := p.next()
+	if value.kind != tokenWord && value.kind != tokenString {
+		return nil, p.unexpected(value, fmt.Sprintf("expected a value after %q", op.text))
+	}
+
+	return comparisonNode{field: field, op: op, value: value}, nil
+}
@@ -0,0 +1,166 @@
+package search
+
+import (
+	stderrors "errors"
+	"strings"
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/errors"
+)
+
+func TestFilterPeopleByExpression(t *testing.T) {
+	people := []domain.Person{
+		{Name: "Luke Skywalker", Mass: domain.Measure(77), Gender: "male", Create: "2014-12-09",
+			Films: []string{"https://swapi.dev/api/films/1/", "https://swapi.dev/api/films/2/"}},
+		{Name: "Darth Vader", Mass: domain.Measure(136), Gender: "male", Create: "2014-12-10",
+			Films: []string{"https://swapi.dev/api/films/1/"}},
+		{Name: "Leia Organa", Mass: domain.Measure(49), Gender: "female", Create: "2014-12-10",
+			Films: []string{"https://swapi.dev/api/films/2/"}},
+		{Name: "Arvel Crynyd", Gender: "male", Create: "2014-12-20"},
+	}
+
+	tests := []struct {
+		name      string
+		expr      string
+		wantNames []string
+		wantErr   error
+	}{
+		{
+			name:      "numeric and text comparison",
+			expr:      "mass>80 and gender=male",
+			wantNames: []string{"Darth Vader"},
+		},
+		{
+			name:      "date comparison",
+			expr:      "created>=2014-12-10",
+			wantNames: []string{"Darth Vader", "Leia Organa", "Arvel Crynyd"},
+		},
+		{
+			name:      "list contains quoted value",
+			expr:      `films~"/films/2/"`,
+			wantNames: []string{"Luke Skywalker", "Leia Organa"},
+		},
+		{
+			name:      "unknown mass never matches a numeric comparison",
+			expr:      "mass<50",
+			wantNames: []string{"Leia Organa"},
+		},
+		{
+			name:      "or, not and parentheses",
+			expr:      "not (gender=male) or (name~vader and mass!=0)",
+			wantNames: []string{"Darth Vader", "Leia Organa"},
+		},
+		{
+			name:      "keywords and text values are case-insensitive",
+			expr:      "gender=FEMALE AND name~organa",
+			wantNames: []string{"Leia Organa"},
+		},
+		{
+			name:      "list inequality matches when no element equals",
+			expr:      `films!="https://swapi.dev/api/films/1/"`,
+			wantNames: []string{"Leia Organa", "Arvel Crynyd"},
+		},
+		{
+			name:      "empty expression returns all",
+			expr:      "  ",
+			wantNames: []string{"Luke Skywalker", "Darth Vader", "Leia Organa", "Arvel Crynyd"},
+		},
+		{
+			name:    "no match returns error",
+			expr:    "mass>1000",
+			wantErr: errors.ErrPersonNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FilterPeopleByExpression(people, tt.expr)
+
+			if err != tt.wantErr {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if len(result) != len(tt.wantNames) {
+				t.Fatalf("got %d results, want %d", len(result), len(tt.wantNames))
+			}
+			for i, person := range result {
+				if person.Name != tt.wantNames[i] {
+					t.Errorf("position %d: got %s, want %s", i, person.Name, tt.wantNames[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFilterPlanetsByExpression(t *testing.T) {
+	planets := []domain.Planet{
+		{Name: "Tatooine", Population: domain.Measure(200000), Climate: []string{"arid"}},
+		{Name: "Naboo", Population: domain.Measure(4500000000), Climate: []string{"temperate"}},
+		{Name: "Kamino", Population: domain.Measure(1000000000), Climate: []string{"temperate"}},
+	}
+
+	result, err := FilterPlanetsByExpression(planets, "climate=temperate and population>=1000000000")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 2 || result[0].Name != "Naboo" || result[1].Name != "Kamino" {
+		t.Errorf("got %v, want Naboo and Kamino", result)
+	}
+}
+
+func TestValidateExpression_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		expr      string
+		validate  func(string) error
+		wantPos   int
+		wantToken string
+	}{
+		{name: "unknown field", expr: "mass>80 and colour=red", validate: ValidatePeopleExpression, wantPos: 13, wantToken: "colour"},
+		{name: "operator not supported for text", expr: "gender>male", validate: ValidatePeopleExpression, wantPos: 7, wantToken: ">"},
+		{name: "number expected", expr: "mass>heavy", validate: ValidatePeopleExpression, wantPos: 6, wantToken: "heavy"},
+		{name: "date expected", expr: "created>=yesterday", validate: ValidatePeopleExpression, wantPos: 10, wantToken: "yesterday"},
+		{name: "missing operator", expr: "mass 80", validate: ValidatePeopleExpression, wantPos: 6, wantToken: "80"},
+		{name: "missing value", expr: "mass>", validate: ValidatePeopleExpression, wantPos: 6},
+		{name: "unknown operator", expr: "mass!80", validate: ValidatePeopleExpression, wantPos: 5, wantToken: "!"},
+		{name: "unterminated string", expr: `films~"/films/1/`, validate: ValidatePeopleExpression, wantPos: 7, wantToken: `"/films/1/`},
+		{name: "unclosed parenthesis", expr: "(mass>80", validate: ValidatePeopleExpression, wantPos: 9},
+		{name: "trailing tokens", expr: "mass>80 gender=male", validate: ValidatePeopleExpression, wantPos: 9, wantToken: "gender"},
+		{name: "people field on films", expr: "mass>80", validate: ValidateFilmExpression, wantPos: 1, wantToken: "mass"},
+		{name: "planet text field", expr: "population~many", validate: ValidatePlanetExpression, wantPos: 11, wantToken: "~"},
+		{name: "not nested too deeply", expr: strings.Repeat("not ", 33) + "mass>80", validate: ValidatePeopleExpression, wantPos: 129, wantToken: "not"},
+		{name: "parentheses nested too deeply", expr: strings.Repeat("(", 33) + "mass>80" + strings.Repeat(")", 33), validate: ValidatePeopleExpression, wantPos: 33, wantToken: "("},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validate(tt.expr)
+
+			var parseErr *ParseError
+			if !stderrors.As(err, &parseErr) {
+				t.Fatalf("got error %v, want *ParseError", err)
+			}
+			if parseErr.Pos != tt.wantPos {
+				t.Errorf("got position %d, want %d (%v)", parseErr.Pos, tt.wantPos, parseErr)
+			}
+			if parseErr.Token != tt.wantToken {
+				t.Errorf("got token %q, want %q", parseErr.Token, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestValidateExpression_Valid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"episodeId<=3",
+		`director="George Lucas" or releaseDate>1999-01-01`,
+		strings.Repeat("not ", 32) + "episodeId<=3",
+		strings.Repeat("(", 32) + "episodeId<=3" + strings.Repeat(")", 32),
+	} {
+		if err := ValidateFilmExpression(expr); err != nil {
+			t.Errorf("ValidateFilmExpression(%q) = %v, want nil", expr, err)
+		}
+	}
+}
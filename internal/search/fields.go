@@ -0,0 +1,278 @@
+package search
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+// fieldKind is the type of an entity field as seen by filter expressions.
+// It decides which operators are allowed and how the value literal is parsed.
+type fieldKind int
+
+const (
+	kindText   fieldKind = iota // Case-insensitive =, != and ~ (contains)
+	kindNumber                  // All comparisons; unknown values never match
+	kindDate                    // All comparisons against YYYY-MM-DD
+	kindList                    // = / ~ match any element, != matches when no element equals
+)
+
+// dateLayout is the date format used by created/edited/releaseDate fields.
+const dateLayout = "2006-01-02"
+
+// operatorsByKind lists the operators each field kind supports.
+var operatorsByKind = map[fieldKind][]string{
+	kindText:   {"=", "!=", "~"},
+	kindNumber: {"=", "!=", ">", ">=", "<", "<="},
+	kindDate:   {"=", "!=", ">", ">=", "<", "<="},
+	kindList:   {"=", "!=", "~"},
+}
+
+// kindNames is used in error messages.
+var kindNames = map[fieldKind]string{
+	kindText:   "text",
+	kindNumber: "numeric",
+	kindDate:   "date",
+	kindList:   "list",
+}
+
+// field resolves one filterable attribute of T. Exactly one getter is set, matching kind.
+type field[T any] struct {
+	kind   fieldKind
+	text   func(T) string             // kindText and kindDate
+	number func(T) domain.Measurement // kindNumber
+	list   func(T) []string           // kindList
+}
+
+func textField[T any](get func(T) string) field[T] {
+	return field[T]{kind: kindText, text: get}
+}
+
+func dateField[T any](get func(T) string) field[T] {
+	return field[T]{kind: kindDate, text: get}
+}
+
+func numberField[T any](get func(T) domain.Measurement) field[T] {
+	return field[T]{kind: kindNumber, number: get}
+}
+
+func listField[T any](get func(T) []string) field[T] {
+	return field[T]{kind: kindList, list: get}
+}
+
+// known wraps a getter whose value is always known.
+func known[T any](get func(T) int) func(T) domain.Measurement {
+	return func(item T) domain.Measurement { return domain.Measure(float64(get(item))) }
+}
+
+// peopleFields are the fields usable in people filter expressions, named as in the JSON response.
+var peopleFields = map[string]field[domain.Person]{
+	"id":          textField(func(p domain.Person) string { return p.ID }),
+	"name":        textField(func(p domain.Person) string { return p.Name }),
+	"height":      numberField(func(p domain.Person) domain.Measurement { return p.Height }),
+	"mass":        numberField(func(p domain.Person) domain.Measurement { return p.Mass }),
+	"hairColor":   textField(func(p domain.Person) string { return p.HairColor }),
+	"skinColor":   textField(func(p domain.Person) string { return p.SkinColor }),
+	"eyeColor":    textField(func(p domain.Person) string { return p.EyeColor }),
+	"birthYear":   textField(func(p domain.Person) string { return p.BirthYear }),
+	"gender":      textField(func(p domain.Person) string { return p.Gender }),
+	"created":     dateField(func(p domain.Person) string { return p.Create }),
+	"edited":      dateField(func(p domain.Person) string { return p.Edited }),
+	"homeworld":   textField(func(p domain.Person) string { return p.Homeworld }),
+	"homeworldId": textField(func(p domain.Person) string { return p.HomeworldID }),
+	"films":       listField(func(p domain.Person) []string { return p.Films }),
+	"filmIds":     listField(func(p domain.Person) []string { return p.FilmIDs }),
+	"species":     listField(func(p domain.Person) []string { return p.Species }),
+	"speciesIds":  listField(func(p domain.Person) []string { return p.SpeciesIDs }),
+	"vehicles":    listField(func(p domain.Person) []string { return p.Vehicles }),
+	"vehicleIds":  listField(func(p domain.Person) []string { return p.VehicleIDs }),
+	"starships":   listField(func(p domain.Person) []string { return p.Starships }),
+	"starshipIds": listField(func(p domain.Person) []string { return p.StarshipIDs }),
+}
+
+// planetFields are the fields usable in planet filter expressions, named as in the JSON response.
+var planetFields = map[string]field[domain.Planet]{
+	"id":             textField(func(p domain.Planet) string { return p.ID }),
+	"name":           textField(func(p domain.Planet) string { return p.Name }),
+	"rotationPeriod": numberField(func(p domain.Planet) domain.Measurement { return p.RotationPeriod }),
+	"orbitalPeriod":  numberField(func(p domain.Planet) domain.Measurement { return p.OrbitalPeriod }),
+	"diameter":       numberField(func(p domain.Planet) domain.Measurement { return p.Diameter }),
+	"gravity":        textField(func(p domain.Planet) string { return p.Gravity }),
+	"population":     numberField(func(p domain.Planet) domain.Measurement { return p.Population }),
+	"surfaceWater":   numberField(func(p domain.Planet) domain.Measurement { return p.SurfaceWater }),
+	"climate":        listField(func(p domain.Planet) []string { return p.Climate }),
+	"terrain":        listField(func(p domain.Planet) []string { return p.Terrain }),
+	"residents":      listField(func(p domain.Planet) []string { return p.Resident }),
+	"residentIds":    listField(func(p domain.Planet) []string { return p.ResidentIDs }),
+	"films":          listField(func(p domain.Planet) []string { return p.Films }),
+	"filmIds":        listField(func(p domain.Planet) []string { return p.FilmIDs }),
+	"created":        dateField(func(p domain.Planet) string { return p.Created }),
+}
+
+// filmFields are the fields usable in film filter expressions, named as in the JSON response.
+var filmFields = map[string]field[domain.Film]{
+	"id":           textField(func(f domain.Film) string { return f.ID }),
+	"title":        textField(func(f domain.Film) string { return f.Title }),
+	"episodeId":    numberField(known(func(f domain.Film) int { return f.EpisodeID })),
+	"director":     textField(func(f domain.Film) string { return f.Director }),
+	"producer":     textField(func(f domain.Film) string { return f.Producer }),
+	"releaseDate":  dateField(func(f domain.Film) string { return f.ReleaseDate }),
+	"created":      dateField(func(f domain.Film) string { return f.Created }),
+	"characters":   listField(func(f domain.Film) []string { return f.Characters }),
+	"characterIds": listField(func(f domain.Film) []string { return f.CharacterIDs }),
+	"planets":      listField(func(f domain.Film) []string { return f.Planets }),
+	"planetIds":    listField(func(f domain.Film) []string { return f.PlanetIDs }),
+}
+
+// predicate reports whether an item matches a compiled filter expression.
+type predicate[T any] func(T) bool
+
+// compileExpression parses expr and resolves its fields against fields.
+// An empty expression matches everything.
+func compileExpression[T any](expr string, fields map[string]field[T]) (predicate[T], error) {
+	if strings.TrimSpace(expr) == "" {
+		return func(T) bool { return true }, nil
+	}
+
+	tree, err := parseExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return compileNode(tree, fields)
+}
+
+func compileNode[T any](n node, fields map[string]field[T]) (predicate[T], error) {
+	switch n := n.(type) {
+	case logicalNode:
+		left, err := compileNode(n.left, fields)
+		if err != nil {
+			return nil, err
+		}
+		right, err := compileNode(n.right, fields)
+		if err != nil {
+			return nil, err
+		}
+		if n.op == "or" {
+			return func(item T) bool { return left(item) || right(item) }, nil
+		}
+		return func(item T) bool { return left(item) && right(item) }, nil
+
+	case notNode:
+		operand, err := compileNode(n.operand, fields)
+		if err != nil {
+			return nil, err
+		}
+		return func(item T) bool { return !operand(item) }, nil
+
+	case comparisonNode:
+		return compileComparison(n, fields)
+	}
+
+	return nil, fmt.Errorf("unsupported filter node %T", n)
+}
+
+func compileComparison[T any](n comparisonNode, fields map[string]field[T]) (predicate[T], error) {
+	f, ok := fields[n.field.text]
+	if !ok {
+		return nil, &ParseError{
+			Pos:     n.field.pos,
+			Token:   n.field.text,
+			Message: fmt.Sprintf("unknown field %q (allowed: %s)", n.field.text, strings.Join(fieldNames(fields), ", ")),
+		}
+	}
+
+	op := n.op.text
+	if !slices.Contains(operatorsByKind[f.kind], op) {
+		return nil, &ParseError{
+			Pos:     n.op.pos,
+			Token:   op,
+			Message: fmt.Sprintf("operator %q is not supported for %s field %q", op, kindNames[f.kind], n.field.text),
+		}
+	}
+
+	literal := n.value.text
+	switch f.kind {
+	case kindNumber:
+		want, err := strconv.ParseFloat(literal, 64)
+		if err != nil {
+			return nil, &ParseError{Pos: n.value.pos, Token: literal, Message: fmt.Sprintf("field %q expects a number", n.field.text)}
+		}
+		return func(item T) bool {
+			got := f.number(item)
+			return got.Known && compareOrdered(got.Value, want, op)
+		}, nil
+
+	case kindDate:
+		want, err := time.Parse(dateLayout, literal)
+		if err != nil {
+			return nil, &ParseError{Pos: n.value.pos, Token: literal, Message: fmt.Sprintf("field %q expects a date (YYYY-MM-DD)", n.field.text)}
+		}
+		return func(item T) bool {
+			got, err := time.Parse(dateLayout, f.text(item))
+			return err == nil && compareOrdered(got.Unix(), want.Unix(), op)
+		}, nil
+
+	case kindList:
+		return func(item T) bool {
+			matched := slices.ContainsFunc(f.list(item), func(element string) bool {
+				return matchText(element, literal, op)
+			})
+			if op == "!=" {
+				return !matched
+			}
+			return matched
+		}, nil
+	}
+
+	return func(item T) bool {
+		matched := matchText(f.text(item), literal, op)
+		if op == "!=" {
+			return !matched
+		}
+		return matched
+	}, nil
+}
+
+// matchText compares case-insensitively: "~" is a substring match, "=" and "!=" test equality
+// (the caller negates for "!=").
+func matchText(value, want, op string) bool {
+	if op == "~" {
+		return strings.Contains(strings.ToLower(value), strings.ToLower(want))
+	}
+	return strings.EqualFold(value, want)
+}
+
+// compareOrdered applies a comparison operator to two ordered values.
+func compareOrdered[N int64 | float64](got, want N, op string) bool {
+	switch op {
+	case "=":
+		return got == want
+	case "!=":
+		return got != want
+	case ">":
+		return got > want
+	case ">=":
+		return got >= want
+	case "<":
+		return got < want
+	case "<=":
+		return got <= want
+	}
+	return false
+}
+
+// fieldNames returns the field names in alphabetical order for error messages.
+func fieldNames[T any](fields map[string]field[T]) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -1,6 +1,7 @@
 package search
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/stressedbypull/swapi-connector/internal/domain"
@@ -11,44 +12,179 @@ import (
 // Example: "sky" matches "Luke Skywalker", "Anakin Skywalker".
 // Returns ErrPersonNotFound if search is provided but no results are found.
 func FilterPeopleByName(people []domain.Person, search string) ([]domain.Person, error) {
-	if search == "" {
-		return people, nil
+	return filterByName(people, search, errors.ErrPersonNotFound)
+}
+
+// FilterPeopleByText filters people where any of the texts returned for them (their name,
+// homeworld name, film titles, ...) contains search, case-insensitive.
+// Example: "tatooine" with homeworld names matches everyone born on Tatooine.
+// Returns ErrPersonNotFound if search is provided but no results are found.
+func FilterPeopleByText(people []domain.Person, search string, texts func(domain.Person) []string) ([]domain.Person, error) {
+	return filterByText(people, search, texts, errors.ErrPersonNotFound)
+}
+
+// FilterPlanetsByName filters planets by name using case-insensitive partial match.
+// Returns ErrPlanetNotFound if search is provided but no results are found.
+func FilterPlanetsByName(planets []domain.Planet, search string) ([]domain.Planet, error) {
+	return filterByName(planets, search, errors.ErrPlanetNotFound)
+}
+
+// FilterFilmsByTitle filters films by title using case-insensitive partial match.
+// Returns ErrFilmNotFound if search is provided but no results are found.
+func FilterFilmsByTitle(films []domain.Film, search string) ([]domain.Film, error) {
+	return filterByName(films, search, errors.ErrFilmNotFound)
+}
+
+// FilterStarshipsByName filters starships by name using case-insensitive partial match.
+// Returns ErrStarshipNotFound if search is provided but no results are found.
+func FilterStarshipsByName(starships []domain.Starship, search string) ([]domain.Starship, error) {
+	return filterByName(starships, search, errors.ErrStarshipNotFound)
+}
+
+// FilterVehiclesByName filters vehicles by name using case-insensitive partial match.
+// Returns ErrVehicleNotFound if search is provided but no results are found.
+func FilterVehiclesByName(vehicles []domain.Vehicle, search string) ([]domain.Vehicle, error) {
+	return filterByName(vehicles, search, errors.ErrVehicleNotFound)
+}
+
+// FilterSpeciesByName filters species by name using case-insensitive partial match.
+// Returns ErrSpeciesNotFound if search is provided but no results are found.
+func FilterSpeciesByName(species []domain.Species, search string) ([]domain.Species, error) {
+	return filterByName(species, search, errors.ErrSpeciesNotFound)
+}
+
+// FilterPeopleByExpression keeps people matching a filter expression such as
+// `mass>80 and gender=male`. See expression.go for the grammar.
+// Returns a *ParseError for invalid expressions and ErrPersonNotFound if nothing matches.
+func FilterPeopleByExpression(people []domain.Person, expr string) ([]domain.Person, error) {
+	return filterByExpression(people, expr, peopleFields, errors.ErrPersonNotFound)
+}
+
+// FilterPlanetsByExpression keeps planets matching a filter expression such as `population>1000000`.
+// Returns a *ParseError for invalid expressions and ErrPlanetNotFound if nothing matches.
+func FilterPlanetsByExpression(planets []domain.Planet, expr string) ([]domain.Planet, error) {
+	return filterByExpression(planets, expr, planetFields, errors.ErrPlanetNotFound)
+}
+
+// FilterFilmsByExpression keeps films matching a filter expression such as `episodeId<=3`.
+// Returns a *ParseError for invalid expressions and ErrFilmNotFound if nothing matches.
+func FilterFilmsByExpression(films []domain.Film, expr string) ([]domain.Film, error) {
+	return filterByExpression(films, expr, filmFields, errors.ErrFilmNotFound)
+}
+
+// ValidatePeopleExpression checks a people filter expression without applying it.
+// Returns a *ParseError pointing at the offending token.
+func ValidatePeopleExpression(expr string) error {
+	_, err := compileExpression(expr, peopleFields)
+	return err
+}
+
+// ValidatePlanetExpression checks a planet filter expression without applying it.
+func ValidatePlanetExpression(expr string) error {
+	_, err := compileExpression(expr, planetFields)
+	return err
+}
+
+// ValidateFilmExpression checks a film filter expression without applying it.
+func ValidateFilmExpression(expr string) error {
+	_, err := compileExpression(expr, filmFields)
+	return err
+}
+
+// FilterSpeciesByAttributes keeps species whose classification, designation and
+// language exactly match the non-empty filter fields (case-insensitive).
+// Example: {Classification: "mammal"} matches "mammal" but not "mammals".
+// Returns ErrSpeciesNotFound if a filter is provided but no results are found.
+func FilterSpeciesByAttributes(species []domain.Species, filter domain.SpeciesFilter) ([]domain.Species, error) {
+	if filter == (domain.SpeciesFilter{}) {
+		return species, nil
 	}
 
-	searchLower := strings.ToLower(search)
-	filtered := make([]domain.Person, 0)
+	filtered := make([]domain.Species, 0)
 
-	for _, person := range people {
-		if strings.Contains(strings.ToLower(person.Name), searchLower) {
-			filtered = append(filtered, person)
+	for _, s := range species {
+		if matchesExact(s.Classification, filter.Classification) &&
+			matchesExact(s.Designation, filter.Designation) &&
+			matchesExact(s.Language, filter.Language) {
+			filtered = append(filtered, s)
 		}
 	}
 
 	if len(filtered) == 0 {
-		return nil, errors.ErrPersonNotFound
+		return nil, errors.ErrSpeciesNotFound
 	}
 
 	return filtered, nil
 }
 
-// FilterPlanetsByName filters planets by name using case-insensitive partial match.
-// Returns ErrPlanetNotFound if search is provided but no results are found.
-func FilterPlanetsByName(planets []domain.Planet, search string) ([]domain.Planet, error) {
+// matchesExact reports whether value equals want, ignoring case.
+// An empty want matches everything.
+func matchesExact(value, want string) bool {
+	return want == "" || strings.EqualFold(value, want)
+}
+
+// named is implemented by every domain entity with a display name.
+type named interface {
+	GetName() string
+}
+
+// filterByName filters items by name using case-insensitive partial match.
+// Returns notFound if search is provided but no results are found.
+func filterByName[T named](items []T, search string, notFound error) ([]T, error) {
+	return filterByText(items, search, nameOf[T], notFound)
+}
+
+// nameOf returns the item's name as its only searchable text.
+func nameOf[T named](item T) []string {
+	return []string{item.GetName()}
+}
+
+// filterByText keeps items where any of their texts contains search (case-insensitive).
+// Returns notFound if search is provided but no results are found.
+func filterByText[T any](items []T, search string, texts func(T) []string, notFound error) ([]T, error) {
 	if search == "" {
-		return planets, nil
+		return items, nil
 	}
 
 	searchLower := strings.ToLower(search)
-	filtered := make([]domain.Planet, 0)
+	filtered := make([]T, 0)
+
+	for _, item := range items {
+		if slices.ContainsFunc(texts(item), func(text string) bool {
+			return strings.Contains(strings.ToLower(text), searchLower)
+		}) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	if len(filtered) == 0 {
+		return nil, notFound
+	}
+
+	return filtered, nil
+}
+
+// filterByExpression keeps the items matching expr, resolved against fields.
+// Returns notFound if expr is provided but no results are found.
+func filterByExpression[T any](items []T, expr string, fields map[string]field[T], notFound error) ([]T, error) {
+	if strings.TrimSpace(expr) == "" {
+		return items, nil
+	}
+
+	matches, err := compileExpression(expr, fields)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, planet := range planets {
-		if strings.Contains(strings.ToLower(planet.Name), searchLower) {
-			filtered = append(filtered, planet)
+	filtered := make([]T, 0)
+	for _, item := range items {
+		if matches(item) {
+			filtered = append(filtered, item)
 		}
 	}
 
 	if len(filtered) == 0 {
-		return nil, errors.ErrPlanetNotFound
+		return nil, notFound
 	}
 
 	return filtered, nil
@@ -147,3 +147,121 @@ func TestFilterPlanetsByName(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterFilmsByTitle(t *testing.T) {
+	films := []domain.Film{
+		{Title: "A New Hope"},
+		{Title: "The Empire Strikes Back"},
+		{Title: "Return of the Jedi"},
+	}
+
+	tests := []struct {
+		name      string
+		search    string
+		wantCount int
+		wantErr   error
+	}{
+		{
+			name:      "search 'hope' returns A New Hope",
+			search:    "hope",
+			wantCount: 1,
+			wantErr:   nil,
+		},
+		{
+			name:      "search 'the' matches multiple titles",
+			search:    "THE",
+			wantCount: 2,
+			wantErr:   nil,
+		},
+		{
+			name:      "no match returns error",
+			search:    "clones",
+			wantCount: 0,
+			wantErr:   errors.ErrFilmNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FilterFilmsByTitle(films, tt.search)
+
+			if err != tt.wantErr {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return // If we expect an error, we're done
+			}
+
+			if len(result) != tt.wantCount {
+				t.Errorf("got %d results, want %d", len(result), tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestFilterSpeciesByAttributes(t *testing.T) {
+	species := []domain.Species{
+		{Name: "Human", Classification: "mammal", Designation: "sentient", Language: "Galactic Basic"},
+		{Name: "Wookie", Classification: "mammal", Designation: "sentient", Language: "Shyriiwook"},
+		{Name: "Trandoshan", Classification: "reptile", Designation: "sentient", Language: "Dosh"},
+		{Name: "Hutt", Classification: "gastropod", Designation: "sentient", Language: "Huttese"},
+	}
+
+	tests := []struct {
+		name      string
+		filter    domain.SpeciesFilter
+		wantNames []string
+		wantErr   error
+	}{
+		{
+			name:      "empty filter returns all",
+			filter:    domain.SpeciesFilter{},
+			wantNames: []string{"Human", "Wookie", "Trandoshan", "Hutt"},
+		},
+		{
+			name:      "classification is exact and case-insensitive",
+			filter:    domain.SpeciesFilter{Classification: "Mammal"},
+			wantNames: []string{"Human", "Wookie"},
+		},
+		{
+			name:    "partial classification does not match",
+			filter:  domain.SpeciesFilter{Classification: "mam"},
+			wantErr: errors.ErrSpeciesNotFound,
+		},
+		{
+			name:      "filters combine with AND",
+			filter:    domain.SpeciesFilter{Classification: "mammal", Language: "shyriiwook"},
+			wantNames: []string{"Wookie"},
+		},
+		{
+			name:      "designation filter",
+			filter:    domain.SpeciesFilter{Designation: "sentient", Language: "Huttese"},
+			wantNames: []string{"Hutt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FilterSpeciesByAttributes(species, tt.filter)
+
+			if err != tt.wantErr {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return // If we expect an error, we're done
+			}
+
+			if len(result) != len(tt.wantNames) {
+				t.Fatalf("got %d results, want %d", len(result), len(tt.wantNames))
+			}
+
+			for i, s := range result {
+				if s.Name != tt.wantNames[i] {
+					t.Errorf("position %d: got %s, want %s", i, s.Name, tt.wantNames[i])
+				}
+			}
+		})
+	}
+}
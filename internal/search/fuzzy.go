@@ -0,0 +1,147 @@
+package search
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/errors"
+)
+
+// DefaultFuzzyThreshold is the minimum relevance a fuzzy match needs when no threshold is given.
+// It lets one typo through in a typical name token ("skywlker" scores ~0.89, "vadr" 0.8).
+const DefaultFuzzyThreshold = 0.7
+
+// substringScore is the token similarity awarded when one token contains the other,
+// so partial words like "sky" still rank just below exact token matches.
+const substringScore = 0.9
+
+// minSubstringRunes is the shortest word that earns substringScore by being contained
+// in the other; shorter words like "a" are contained in almost every name.
+const minSubstringRunes = 3
+
+// FuzzyFilterPeopleByName keeps people whose name is similar to query with at least
+// the given relevance (0-1, 0 uses DefaultFuzzyThreshold) and records the score in Relevance.
+// Word order does not matter: "wan obi" matches "Obi-Wan Kenobi".
+// Returns ErrPersonNotFound if query is provided but nothing is similar enough.
+func FuzzyFilterPeopleByName(people []domain.Person, query string, threshold float64) ([]domain.Person, error) {
+	return FuzzyFilterPeopleByText(people, query, threshold, nameOf[domain.Person])
+}
+
+// FuzzyFilterPeopleByText is FuzzyFilterPeopleByName over several texts per person
+// (name, homeworld name, film titles, ...); the best-matching text sets the relevance.
+func FuzzyFilterPeopleByText(people []domain.Person, query string, threshold float64, texts func(domain.Person) []string) ([]domain.Person, error) {
+	return fuzzyFilter(people, query, threshold, texts, errors.ErrPersonNotFound, func(p *domain.Person, score float64) {
+		p.Relevance = score
+	})
+}
+
+// FuzzyFilterPlanetsByName keeps planets whose name is similar to query and records the score in Relevance.
+// Returns ErrPlanetNotFound if query is provided but nothing is similar enough.
+func FuzzyFilterPlanetsByName(planets []domain.Planet, query string, threshold float64) ([]domain.Planet, error) {
+	return fuzzyFilter(planets, query, threshold, nameOf[domain.Planet], errors.ErrPlanetNotFound, func(p *domain.Planet, score float64) {
+		p.Relevance = score
+	})
+}
+
+// fuzzyFilter keeps items whose best text scores at least threshold against query, in their original order.
+func fuzzyFilter[T any](items []T, query string, threshold float64, texts func(T) []string, notFound error, setScore func(*T, float64)) ([]T, error) {
+	if strings.TrimSpace(query) == "" {
+		return items, nil
+	}
+	if threshold <= 0 {
+		threshold = DefaultFuzzyThreshold
+	}
+
+	filtered := make([]T, 0)
+	for _, item := range items {
+		score := 0.0
+		for _, text := range texts(item) {
+			score = max(score, Similarity(query, text))
+		}
+		if score >= threshold {
+			setScore(&item, math.Round(score*1000)/1000)
+			filtered = append(filtered, item)
+		}
+	}
+
+	if len(filtered) == 0 {
+		return nil, notFound
+	}
+
+	return filtered, nil
+}
+
+// Similarity scores how well query matches name, from 0 (unrelated) to 1 (every query word found).
+// Both are split into lower-case words; each query word is scored against its closest name
+// word by normalized edit distance, and the score is the average over query words.
+// Examples: ("skywlker", "Luke Skywalker") -> 0.89, ("obi wan", "Obi-Wan Kenobi") -> 1
+func Similarity(query, name string) float64 {
+	queryWords := words(query)
+	nameWords := words(name)
+	if len(queryWords) == 0 || len(nameWords) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for _, q := range queryWords {
+		best := 0.0
+		for _, n := range nameWords {
+			if score := wordSimilarity(q, n); score > best {
+				best = score
+			}
+		}
+		total += best
+	}
+
+	return total / float64(len(queryWords))
+}
+
+// wordSimilarity compares two lower-case words.
+func wordSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	score := 1 - float64(levenshtein(ra, rb))/float64(longest)
+
+	if min(len(ra), len(rb)) >= minSubstringRunes && (strings.Contains(b, a) || strings.Contains(a, b)) {
+		score = max(score, substringScore)
+	}
+
+	return score
+}
+
+// words splits s into lower-case words on anything that is not a letter or digit.
+// Example: "Obi-Wan Kenobi" -> ["obi", "wan", "kenobi"]
+func words(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// levenshtein returns the edit distance between a and b (insertions, deletions, substitutions).
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
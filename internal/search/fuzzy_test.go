@@ -0,0 +1,120 @@
+package search
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/errors"
+)
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		text  string
+		want  float64
+	}{
+		{name: "exact word", query: "luke", text: "Luke Skywalker", want: 1},
+		{name: "one typo", query: "skywlker", text: "Luke Skywalker", want: 0.889},
+		{name: "hyphenated name split into words", query: "obi wan", text: "Obi-Wan Kenobi", want: 1},
+		{name: "word order does not matter", query: "skywalker luke", text: "Luke Skywalker", want: 1},
+		{name: "partial word", query: "sky", text: "Luke Skywalker", want: 0.9},
+		{name: "one letter gets no substring bonus", query: "a", text: "Luke Skywalker", want: 0.111},
+		{name: "two letters get no substring bonus", query: "lu", text: "Luke Skywalker", want: 0.5},
+		{name: "unrelated scores far below threshold", query: "yoda", text: "Chewbacca", want: 0.111},
+		{name: "empty query", query: " ", text: "Yoda", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Similarity(tt.query, tt.text)
+			if math.Abs(got-tt.want) > 0.001 {
+				t.Errorf("Similarity(%q, %q) = %.3f, want %.3f", tt.query, tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFuzzyFilterPeopleByName(t *testing.T) {
+	people := []domain.Person{
+		{Name: "Luke Skywalker"},
+		{Name: "Darth Vader"},
+		{Name: "Obi-Wan Kenobi"},
+		{Name: "Anakin Skywalker"},
+	}
+
+	tests := []struct {
+		name       string
+		query      string
+		threshold  float64
+		wantNames  []string
+		wantScores []float64
+		wantErr    error
+	}{
+		{
+			name:       "typo with default threshold",
+			query:      "skywlker",
+			wantNames:  []string{"Luke Skywalker", "Anakin Skywalker"},
+			wantScores: []float64{0.889, 0.889},
+		},
+		{
+			name:       "token order independent",
+			query:      "wan obi",
+			wantNames:  []string{"Obi-Wan Kenobi"},
+			wantScores: []float64{1},
+		},
+		{
+			name:      "strict threshold rejects typos",
+			query:     "skywlker",
+			threshold: 0.95,
+			wantErr:   errors.ErrPersonNotFound,
+		},
+		{
+			name:       "loose threshold admits weaker matches",
+			query:      "vadr",
+			threshold:  0.5,
+			wantNames:  []string{"Darth Vader"},
+			wantScores: []float64{0.8},
+		},
+		{
+			name:       "empty query returns all unscored",
+			query:      "",
+			wantNames:  []string{"Luke Skywalker", "Darth Vader", "Obi-Wan Kenobi", "Anakin Skywalker"},
+			wantScores: []float64{0, 0, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FuzzyFilterPeopleByName(people, tt.query, tt.threshold)
+
+			if err != tt.wantErr {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if len(result) != len(tt.wantNames) {
+				t.Fatalf("got %d results, want %d", len(result), len(tt.wantNames))
+			}
+			for i, person := range result {
+				if person.Name != tt.wantNames[i] {
+					t.Errorf("position %d: got %s, want %s", i, person.Name, tt.wantNames[i])
+				}
+				if person.Relevance != tt.wantScores[i] {
+					t.Errorf("position %d: got relevance %v, want %v", i, person.Relevance, tt.wantScores[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFuzzyFilterPlanetsByName(t *testing.T) {
+	planets := []domain.Planet{{Name: "Tatooine"}, {Name: "Alderaan"}}
+
+	result, err := FuzzyFilterPlanetsByName(planets, "tatoine", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 || result[0].Name != "Tatooine" || result[0].Relevance == 0 {
+		t.Errorf("got %+v, want scored Tatooine", result)
+	}
+}
@@ -0,0 +1,121 @@
+package search
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+// PrefixIndex is an immutable sorted index of names for fast prefix completion.
+// Every word of a name is indexed, so "sky" completes "Luke Skywalker".
+// Build a new index to pick up new names; lookups are safe for concurrent use.
+type PrefixIndex struct {
+	entries []indexEntry // Sorted by key
+}
+
+// indexEntry points one lower-cased name suffix, starting at a word boundary, at its suggestion.
+type indexEntry struct {
+	key        string
+	wholeName  bool // The key starts at the beginning of the name
+	suggestion domain.Suggestion
+}
+
+// NewPrefixIndex indexes the names of suggestions.
+func NewPrefixIndex(suggestions []domain.Suggestion) *PrefixIndex {
+	entries := make([]indexEntry, 0, len(suggestions))
+	for _, s := range suggestions {
+		name := strings.ToLower(s.Name)
+		for _, start := range wordStarts(name) {
+			entries = append(entries, indexEntry{key: name[start:], wholeName: start == 0, suggestion: s})
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+	return &PrefixIndex{entries: entries}
+}
+
+// Len returns the number of indexed names (one per word).
+func (idx *PrefixIndex) Len() int {
+	return len(idx.entries)
+}
+
+// Lookup returns up to limit suggestions whose name, or a word in it, starts with prefix
+// (case-insensitive). Only the given types are returned; no types means all.
+//
+// Ranking: names starting with prefix come before names with a later word matching,
+// then shorter names first, then alphabetical.
+func (idx *PrefixIndex) Lookup(prefix string, types []string, limit int) []domain.Suggestion {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if prefix == "" || limit <= 0 {
+		return []domain.Suggestion{}
+	}
+
+	// Binary search for the first key >= prefix; all matches follow contiguously
+	first := sort.Search(len(idx.entries), func(i int) bool { return idx.entries[i].key >= prefix })
+
+	// A name can match at several words; keep its best match only
+	best := make(map[domain.Suggestion]indexEntry)
+	for _, e := range idx.entries[first:] {
+		if !strings.HasPrefix(e.key, prefix) {
+			break
+		}
+		if len(types) > 0 && !slices.Contains(types, e.suggestion.Type) {
+			continue
+		}
+		if current, ok := best[e.suggestion]; !ok || (e.wholeName && !current.wholeName) {
+			best[e.suggestion] = e
+		}
+	}
+
+	matches := make([]indexEntry, 0, len(best))
+	for _, e := range best {
+		matches = append(matches, e)
+	}
+	sort.Slice(matches, func(i, j int) bool { return rankedBefore(matches[i], matches[j]) })
+
+	results := make([]domain.Suggestion, 0, min(limit, len(matches)))
+	for _, e := range matches[:min(limit, len(matches))] {
+		results = append(results, e.suggestion)
+	}
+	return results
+}
+
+// rankedBefore orders matches by whole-name match, name length, name, type and ID,
+// so results are deterministic.
+func rankedBefore(a, b indexEntry) bool {
+	if a.wholeName != b.wholeName {
+		return a.wholeName
+	}
+	if len(a.suggestion.Name) != len(b.suggestion.Name) {
+		return len(a.suggestion.Name) < len(b.suggestion.Name)
+	}
+	if a.suggestion.Name != b.suggestion.Name {
+		return a.suggestion.Name < b.suggestion.Name
+	}
+	if a.suggestion.Type != b.suggestion.Type {
+		return a.suggestion.Type < b.suggestion.Type
+	}
+	return a.suggestion.ID < b.suggestion.ID
+}
+
+// wordStarts returns the byte offsets where words start in name:
+// the first letter or digit after a space, hyphen or other separator.
+func wordStarts(name string) []int {
+	var starts []int
+	inWord := false
+	for i, r := range name {
+		isWordRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isWordRune && !inWord {
+			starts = append(starts, i)
+		}
+		inWord = isWordRune
+	}
+	if len(starts) == 0 || starts[0] != 0 {
+		// Always index the whole name, e.g. for names starting with punctuation
+		starts = append([]int{0}, starts...)
+	}
+	return starts
+}
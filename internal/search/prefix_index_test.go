@@ -0,0 +1,52 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+func TestPrefixIndex_Lookup(t *testing.T) {
+	index := NewPrefixIndex([]domain.Suggestion{
+		{Type: "people", ID: "1", Name: "Luke Skywalker"},
+		{Type: "people", ID: "11", Name: "Anakin Skywalker"},
+		{Type: "people", ID: "3", Name: "R2-D2"},
+		{Type: "people", ID: "81", Name: "Lumiya"},
+		{Type: "planets", ID: "8", Name: "Naboo"},
+		{Type: "planets", ID: "5", Name: "Dagobah"},
+		{Type: "films", ID: "1", Name: "A New Hope"},
+	})
+
+	tests := []struct {
+		name   string
+		prefix string
+		types  []string
+		limit  int
+		want   []string // type/id
+	}{
+		{name: "whole-name matches first, shorter first", prefix: "lu", limit: 10, want: []string{"people/81", "people/1"}},
+		{name: "matches later words", prefix: "sky", limit: 10, want: []string{"people/1", "people/11"}},
+		{name: "case-insensitive", prefix: "NAB", limit: 10, want: []string{"planets/8"}},
+		{name: "words split on hyphens", prefix: "d2", limit: 10, want: []string{"people/3"}},
+		{name: "whole-name match ranks above word match", prefix: "n", limit: 10, want: []string{"planets/8", "films/1"}},
+		{name: "type filter", prefix: "n", types: []string{"films"}, limit: 10, want: []string{"films/1"}},
+		{name: "limit", prefix: "sky", limit: 1, want: []string{"people/1"}},
+		{name: "no match", prefix: "xyz", limit: 10, want: []string{}},
+		{name: "empty prefix", prefix: " ", limit: 10, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := index.Lookup(tt.prefix, tt.types, tt.limit)
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("Lookup(%q) returned %v, want %v", tt.prefix, got, tt.want)
+			}
+			for i, s := range got {
+				if key := s.Type + "/" + s.ID; key != tt.want[i] {
+					t.Errorf("result[%d] = %s, want %s", i, key, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package search
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/errors"
+)
+
+// FilterPeopleByRange keeps people whose numeric and date fields fall within every range
+// (bounds inclusive), e.g. mass in [50, 100]. People with an unknown value for a ranged
+// field are dropped unless ranges.IncludeUnknown is set.
+// Returns ErrPersonNotFound if a range is provided but no results are found.
+func FilterPeopleByRange(people []domain.Person, ranges domain.RangeFilter) ([]domain.Person, error) {
+	return filterByRange(people, ranges, peopleFields, errors.ErrPersonNotFound)
+}
+
+// FilterPlanetsByRange keeps planets whose numeric and date fields fall within every range,
+// e.g. population >= 1000000. Unknown values are handled as in FilterPeopleByRange.
+// Returns ErrPlanetNotFound if a range is provided but no results are found.
+func FilterPlanetsByRange(planets []domain.Planet, ranges domain.RangeFilter) ([]domain.Planet, error) {
+	return filterByRange(planets, ranges, planetFields, errors.ErrPlanetNotFound)
+}
+
+// filterByRange keeps the items within all ranges, resolved against fields.
+// Returns notFound if a range is provided but no results are found.
+func filterByRange[T any](items []T, ranges domain.RangeFilter, fields map[string]field[T], notFound error) ([]T, error) {
+	if ranges.IsEmpty() {
+		return items, nil
+	}
+
+	checks := make([]func(T) (inRange, known bool), 0, len(ranges.Numbers)+len(ranges.Dates))
+	for _, r := range ranges.Numbers {
+		f, ok := fields[r.Field]
+		if !ok || f.kind != kindNumber {
+			return nil, fmt.Errorf("field %q does not support numeric ranges", r.Field)
+		}
+		checks = append(checks, numberRangeCheck[T](f, r))
+	}
+	for _, r := range ranges.Dates {
+		f, ok := fields[r.Field]
+		if !ok || f.kind != kindDate {
+			return nil, fmt.Errorf("field %q does not support date ranges", r.Field)
+		}
+		checks = append(checks, dateRangeCheck[T](f, r))
+	}
+
+	filtered := make([]T, 0)
+	for _, item := range items {
+		if withinAll(item, checks, ranges.IncludeUnknown) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	if len(filtered) == 0 {
+		return nil, notFound
+	}
+
+	return filtered, nil
+}
+
+// withinAll reports whether item passes every range check. An unknown value
+// passes only when includeUnknown is set.
+func withinAll[T any](item T, checks []func(T) (inRange, known bool), includeUnknown bool) bool {
+	for _, check := range checks {
+		inRange, known := check(item)
+		if !known {
+			if !includeUnknown {
+				return false
+			}
+			continue
+		}
+		if !inRange {
+			return false
+		}
+	}
+	return true
+}
+
+func numberRangeCheck[T any](f field[T], r domain.NumberRange) func(T) (inRange, known bool) {
+	return func(item T) (bool, bool) {
+		value := f.number(item)
+		if !value.Known {
+			return false, false
+		}
+		return (r.Min == nil || value.Value >= *r.Min) && (r.Max == nil || value.Value <= *r.Max), true
+	}
+}
+
+func dateRangeCheck[T any](f field[T], r domain.DateRange) func(T) (inRange, known bool) {
+	return func(item T) (bool, bool) {
+		value, err := time.Parse(dateLayout, f.text(item))
+		if err != nil {
+			return false, false
+		}
+		return (r.From == nil || !value.Before(*r.From)) && (r.To == nil || !value.After(*r.To)), true
+	}
+}
@@ -0,0 +1,125 @@
+package search
+
+import (
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/errors"
+)
+
+func float(v float64) *float64 { return &v }
+
+func date(s string) *time.Time {
+	t, _ := time.Parse(dateLayout, s)
+	return &t
+}
+
+func TestFilterPeopleByRange(t *testing.T) {
+	people := []domain.Person{
+		{Name: "Luke Skywalker", Mass: domain.Measure(77), Create: "2014-12-09"},
+		{Name: "Darth Vader", Mass: domain.Measure(136), Create: "2014-12-10"},
+		{Name: "Arvel Crynyd", Create: "2014-12-20"},
+		{Name: "Yoda", Mass: domain.Measure(17), Create: "2014-12-15"},
+	}
+
+	tests := []struct {
+		name    string
+		ranges  domain.RangeFilter
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "no ranges keeps everyone",
+			want: []string{"Luke Skywalker", "Darth Vader", "Arvel Crynyd", "Yoda"},
+		},
+		{
+			name:   "massMax excludes unknown mass",
+			ranges: domain.RangeFilter{Numbers: []domain.NumberRange{{Field: "mass", Max: float(80)}}},
+			want:   []string{"Luke Skywalker", "Yoda"},
+		},
+		{
+			name: "includeUnknown keeps unknown mass",
+			ranges: domain.RangeFilter{
+				Numbers:        []domain.NumberRange{{Field: "mass", Max: float(80)}},
+				IncludeUnknown: true,
+			},
+			want: []string{"Luke Skywalker", "Arvel Crynyd", "Yoda"},
+		},
+		{
+			name:   "bounds are inclusive",
+			ranges: domain.RangeFilter{Numbers: []domain.NumberRange{{Field: "mass", Min: float(77), Max: float(136)}}},
+			want:   []string{"Luke Skywalker", "Darth Vader"},
+		},
+		{
+			name:   "created range",
+			ranges: domain.RangeFilter{Dates: []domain.DateRange{{Field: "created", From: date("2014-12-10"), To: date("2014-12-15")}}},
+			want:   []string{"Darth Vader", "Yoda"},
+		},
+		{
+			name: "number and date ranges combined",
+			ranges: domain.RangeFilter{
+				Numbers: []domain.NumberRange{{Field: "mass", Min: float(50)}},
+				Dates:   []domain.DateRange{{Field: "created", From: date("2014-12-10")}},
+			},
+			want: []string{"Darth Vader"},
+		},
+		{
+			name:    "nothing in range",
+			ranges:  domain.RangeFilter{Numbers: []domain.NumberRange{{Field: "mass", Min: float(1000)}}},
+			wantErr: errors.ErrPersonNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FilterPeopleByRange(people, tt.ranges)
+			if tt.wantErr != nil {
+				if !stderrors.Is(err, tt.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(result) != len(tt.want) {
+				t.Fatalf("got %d people, want %d", len(result), len(tt.want))
+			}
+			for i, name := range tt.want {
+				if result[i].Name != name {
+					t.Errorf("result[%d] = %q, want %q", i, result[i].Name, name)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterPlanetsByRange(t *testing.T) {
+	planets := []domain.Planet{
+		{Name: "Tatooine", Population: domain.Measure(200000)},
+		{Name: "Naboo", Population: domain.Measure(4500000000)},
+		{Name: "Hoth"},
+	}
+
+	result, err := FilterPlanetsByRange(planets, domain.RangeFilter{
+		Numbers: []domain.NumberRange{{Field: "population", Min: float(1000000)}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 || result[0].Name != "Naboo" {
+		t.Errorf("got %v, want Naboo", result)
+	}
+}
+
+func TestFilterPeopleByRange_UnsupportedField(t *testing.T) {
+	_, err := FilterPeopleByRange(nil, domain.RangeFilter{
+		Numbers: []domain.NumberRange{{Field: "name", Min: float(1)}},
+	})
+	if err == nil {
+		t.Error("expected an error for a non-numeric field")
+	}
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// CollectionCache keeps a complete upstream collection in memory so whole-collection
+// list requests fetch it once and reuse it until the TTL expires.
+type CollectionCache[T any] struct {
+	load     func(ctx context.Context) ([]T, error)
+	ttl      time.Duration
+	now      func() time.Time
+	mu       sync.Mutex
+	items    []T
+	loadedAt time.Time
+	loaded   bool
+}
+
+// NewCollectionCache creates a cache around load. A zero ttl reloads on every call.
+func NewCollectionCache[T any](ttl time.Duration, load func(ctx context.Context) ([]T, error)) *CollectionCache[T] {
+	return &CollectionCache[T]{
+		load: load,
+		ttl:  ttl,
+		now:  time.Now,
+	}
+}
+
+// Get returns a copy of the cached collection, loading it first if it is missing or stale.
+// Concurrent callers wait for a single load instead of each hitting the upstream.
+// Failed loads are not cached.
+func (c *CollectionCache[T]) Get(ctx context.Context) ([]T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded || c.now().Sub(c.loadedAt) >= c.ttl {
+		items, err := c.load(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.items = items
+		c.loadedAt = c.now()
+		c.loaded = true
+	}
+
+	// Callers filter and sort in place, so never hand out the cached slice itself
+	return slices.Clone(c.items), nil
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionCache_Get(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reuses the collection until the TTL expires", func(t *testing.T) {
+		calls := 0
+		cache := NewCollectionCache(time.Minute, func(context.Context) ([]string, error) {
+			calls++
+			return []string{"a", "b"}, nil
+		})
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		cache.now = func() time.Time { return now }
+
+		_, err := cache.Get(ctx)
+		require.NoError(t, err)
+		_, err = cache.Get(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+
+		now = now.Add(time.Minute)
+		_, err = cache.Get(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("returns a copy callers can reorder", func(t *testing.T) {
+		cache := NewCollectionCache(time.Minute, func(context.Context) ([]string, error) {
+			return []string{"a", "b"}, nil
+		})
+
+		first, err := cache.Get(ctx)
+		require.NoError(t, err)
+		first[0] = "z"
+
+		second, err := cache.Get(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, second)
+	})
+
+	t.Run("does not cache failures", func(t *testing.T) {
+		calls := 0
+		cache := NewCollectionCache(time.Minute, func(context.Context) ([]string, error) {
+			calls++
+			if calls == 1 {
+				return nil, errors.New("upstream down")
+			}
+			return []string{"a"}, nil
+		})
+
+		_, err := cache.Get(ctx)
+		assert.Error(t, err)
+
+		items, err := cache.Get(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, items)
+		assert.Equal(t, 2, calls)
+	})
+}
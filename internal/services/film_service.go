@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/ports"
+	"github.com/stressedbypull/swapi-connector/internal/search"
+	"github.com/stressedbypull/swapi-connector/internal/sorting"
+)
+
+// FilmService handles business logic for film operations.
+type FilmService struct {
+	repo ports.FilmsRepository
+}
+
+// NewFilmService creates a new film service with dependency injection.
+func NewFilmService(r ports.FilmsRepository) *FilmService {
+	return &FilmService{repo: r}
+}
+
+// ListFilms fetches a paginated list of films with search and sorting.
+func (s *FilmService) ListFilms(ctx context.Context, page, pageSize int, searchTerm string, sort []domain.SortKey) (domain.PaginatedResponse[domain.Film], error) {
+	// Fetch from repository
+	result, err := s.repo.FetchFilms(ctx, page, pageSize, searchTerm)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Film]{}, err
+	}
+
+	// Apply search filter
+	filtered, err := search.FilterFilmsByTitle(result.Results, searchTerm)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Film]{}, err
+	}
+	result.Results = filtered
+
+	// Apply sorting if requested
+	sorting.NewChain(sort, sorting.NewFilmSorter).Sort(result.Results)
+
+	return result, nil
+}
+
+// GetFilmByID fetches a single film by ID.
+func (s *FilmService) GetFilmByID(ctx context.Context, id string) (domain.Film, error) {
+	return s.repo.FetchFilmByID(ctx, id)
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFilmService_ListFilms(t *testing.T) {
+	films := []domain.Film{
+		{Title: "The Phantom Menace", EpisodeID: 1, ReleaseDate: "1999-05-19"},
+		{Title: "A New Hope", EpisodeID: 4, ReleaseDate: "1977-05-25"},
+		{Title: "The Empire Strikes Back", EpisodeID: 5, ReleaseDate: "1980-05-17"},
+	}
+
+	tests := []struct {
+		name       string
+		searchTerm string
+		sortBy     string
+		sortOrder  string
+		wantTitles []string
+	}{
+		{
+			name:       "Sort by release date ascending",
+			sortBy:     "releaseDate",
+			sortOrder:  "asc",
+			wantTitles: []string{"A New Hope", "The Empire Strikes Back", "The Phantom Menace"},
+		},
+		{
+			name:       "Sort by episode descending",
+			sortBy:     "episode",
+			sortOrder:  "desc",
+			wantTitles: []string{"The Empire Strikes Back", "A New Hope", "The Phantom Menace"},
+		},
+		{
+			name:       "Sort by title ascending",
+			sortBy:     "title",
+			sortOrder:  "asc",
+			wantTitles: []string{"A New Hope", "The Empire Strikes Back", "The Phantom Menace"},
+		},
+		{
+			name:       "Search by title",
+			searchTerm: "menace",
+			sortOrder:  "asc",
+			wantTitles: []string{"The Phantom Menace"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			mockRepo.On("FetchFilms", mock.Anything, 1, 15, tt.searchTerm).Return(domain.PaginatedResponse[domain.Film]{
+				Count:   len(films),
+				Page:    1,
+				Results: append([]domain.Film(nil), films...),
+			}, nil)
+			service := NewFilmService(mockRepo)
+
+			result, err := service.ListFilms(context.Background(), 1, 15, tt.searchTerm, sortKeys(tt.sortBy, tt.sortOrder))
+
+			assert.NoError(t, err)
+			titles := make([]string, 0, len(result.Results))
+			for _, film := range result.Results {
+				titles = append(titles, film.Title)
+			}
+			assert.Equal(t, tt.wantTitles, titles)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
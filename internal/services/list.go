@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/pagination"
+	"github.com/stressedbypull/swapi-connector/internal/search"
+	"github.com/stressedbypull/swapi-connector/internal/sorting"
+)
+
+// ListSettings configures how services paginate and default list requests.
+type ListSettings struct {
+	PageSize    int             // Page size used when the request does not pick one
+	DefaultMode domain.ListMode // Mode used when the request does not pick one
+}
+
+// pageSize returns the page size for q, falling back to the configured default.
+func (s ListSettings) pageSize(q domain.ListQuery) int {
+	if q.PageSize > 0 {
+		return q.PageSize
+	}
+	return s.PageSize
+}
+
+// mode returns the list mode for q, falling back to the configured default.
+// Queries that must see the complete collection always get collection mode.
+func (s ListSettings) mode(q domain.ListQuery) domain.ListMode {
+	if needsCollection(q) {
+		return domain.ListModeCollection
+	}
+	if q.Mode != "" {
+		return q.Mode
+	}
+	if s.DefaultMode != "" {
+		return s.DefaultMode
+	}
+	return domain.ListModePage
+}
+
+// needsCollection reports whether q can only be answered over the complete collection.
+// Cursor pagination needs it because offsets are only stable across requests when the
+// whole result is filtered and sorted. Fuzzy and related-name searches, filter
+// expressions and ranges need it because SWAPI cannot apply them: matching a single upstream page
+// would miss most matches and report the unfiltered upstream count.
+func needsCollection(q domain.ListQuery) bool {
+	switch {
+	case q.Limit > 0:
+		return true
+	case q.Search != "" && (q.Match == domain.MatchFuzzy || !searchesNameOnly(q)):
+		return true
+	case q.Filter != "" || !q.Ranges.IsEmpty():
+		return true
+	}
+	return false
+}
+
+// listInMemory filters, sorts and then paginates a complete collection,
+// so count/page/pageSize describe the filtered result. A query with a limit
+// returns the window at its offset instead of a page.
+func listInMemory[T sorting.Sortable](
+	items []T,
+	q domain.ListQuery,
+	pageSize int,
+	filter func([]T, domain.ListQuery) ([]T, error),
+	where func([]T, domain.ListQuery) ([]T, error),
+	newSorter func(field string) sorting.Sorter[T],
+) (domain.PaginatedResponse[T], error) {
+	// Apply search, expression and range filters
+	filtered, err := filter(items, q)
+	if err != nil {
+		return domain.PaginatedResponse[T]{}, err
+	}
+	filtered, err = where(filtered, q)
+	if err != nil {
+		return domain.PaginatedResponse[T]{}, err
+	}
+
+	// Apply sorting if requested
+	sorting.NewChain(q.Sort, newSorter).Sort(filtered)
+
+	if q.Limit > 0 {
+		return pagination.Window(filtered, q.Offset, q.Limit), nil
+	}
+	return pagination.Paginate(filtered, q.Page, pageSize), nil
+}
+
+// peopleSearcher returns the search function for people. It matches the fields in
+// q.SearchIn (name by default) using the requested match mode, resolving homeworld,
+// film and species names through names.
+func peopleSearcher(ctx context.Context, names *RelatedNames) func([]domain.Person, domain.ListQuery) ([]domain.Person, error) {
+	return func(people []domain.Person, q domain.ListQuery) ([]domain.Person, error) {
+		if q.Search == "" {
+			return people, nil
+		}
+
+		texts := func(p domain.Person) []string { return []string{p.Name} }
+		if !searchesNameOnly(q) {
+			if names == nil {
+				return nil, errRelatedNamesNotConfigured
+			}
+			var err error
+			if texts, err = names.PersonTexts(ctx, q.SearchIn); err != nil {
+				return nil, err
+			}
+		}
+
+		if q.Match == domain.MatchFuzzy {
+			return search.FuzzyFilterPeopleByText(people, q.Search, q.Threshold, texts)
+		}
+		return search.FilterPeopleByText(people, q.Search, texts)
+	}
+}
+
+// searchesNameOnly reports whether the search targets only the name (the default).
+func searchesNameOnly(q domain.ListQuery) bool {
+	return len(q.SearchIn) == 0 || (len(q.SearchIn) == 1 && q.SearchIn[0] == SearchInName)
+}
+
+// searchPlanets applies the search term to planets using the requested match mode.
+func searchPlanets(planets []domain.Planet, q domain.ListQuery) ([]domain.Planet, error) {
+	if q.Match == domain.MatchFuzzy {
+		return search.FuzzyFilterPlanetsByName(planets, q.Search, q.Threshold)
+	}
+	return search.FilterPlanetsByName(planets, q.Search)
+}
+
+// searchFilms applies the search term to film titles (substring match only).
+func searchFilms(films []domain.Film, q domain.ListQuery) ([]domain.Film, error) {
+	return search.FilterFilmsByTitle(films, q.Search)
+}
+
+// wherePeople applies the filter expression and the numeric/date ranges to people.
+func wherePeople(people []domain.Person, q domain.ListQuery) ([]domain.Person, error) {
+	filtered, err := search.FilterPeopleByExpression(people, q.Filter)
+	if err != nil {
+		return nil, err
+	}
+	return search.FilterPeopleByRange(filtered, q.Ranges)
+}
+
+// wherePlanets applies the filter expression and the numeric/date ranges to planets.
+func wherePlanets(planets []domain.Planet, q domain.ListQuery) ([]domain.Planet, error) {
+	filtered, err := search.FilterPlanetsByExpression(planets, q.Filter)
+	if err != nil {
+		return nil, err
+	}
+	return search.FilterPlanetsByRange(filtered, q.Ranges)
+}
+
+// whereFilms applies the filter expression to films (films have no range filters).
+func whereFilms(films []domain.Film, q domain.ListQuery) ([]domain.Film, error) {
+	return search.FilterFilmsByExpression(films, q.Filter)
+}
@@ -5,51 +5,133 @@ import (
 
 	"github.com/stressedbypull/swapi-connector/internal/domain"
 	"github.com/stressedbypull/swapi-connector/internal/ports"
-	"github.com/stressedbypull/swapi-connector/internal/search"
 	"github.com/stressedbypull/swapi-connector/internal/sorting"
 )
 
 // PeopleService handles business logic for people operations.
 type PeopleService struct {
-	repo ports.PeopleRepository
+	repo       ports.PeopleRepository
+	resolver   *RelationResolver // Optional: nil disables ?expand= and nested collections
+	names      *RelatedNames     // Optional: nil disables searching related names (?searchIn=)
+	settings   ListSettings
+	collection *CollectionCache[domain.Person] // Complete people collection for whole-collection mode
 }
 
 // NewPeopleService creates a new people service with dependency injection.
-func NewPeopleService(repo ports.PeopleRepository) *PeopleService {
+// collection is the shared cache of the complete people collection.
+// The resolver may be nil, in which case expand requests are ignored.
+func NewPeopleService(repo ports.PeopleRepository, collection *CollectionCache[domain.Person], resolver *RelationResolver, names *RelatedNames, settings ListSettings) *PeopleService {
 	return &PeopleService{
-		repo: repo,
+		repo:       repo,
+		resolver:   resolver,
+		names:      names,
+		settings:   settings,
+		collection: collection,
 	}
 }
 
-// ListPeople fetches a paginated list of people with search and sorting.
-func (s *PeopleService) ListPeople(ctx context.Context, page int, searchTerm, sortBy, sortOrder string) (domain.PaginatedResponse[domain.Person], error) {
+// ListPeople fetches a paginated list of people with search, sorting and optional relation expansion.
+// In page mode only the requested upstream page is filtered and sorted; in collection mode
+// the complete collection is filtered and sorted before it is paginated.
+func (s *PeopleService) ListPeople(ctx context.Context, q domain.ListQuery) (domain.PaginatedResponse[domain.Person], error) {
+	var (
+		result domain.PaginatedResponse[domain.Person]
+		err    error
+	)
+
+	if s.settings.mode(q) == domain.ListModeCollection {
+		result, err = s.listCollection(ctx, q)
+	} else {
+		result, err = s.listPage(ctx, q)
+	}
+	if err != nil {
+		return domain.PaginatedResponse[domain.Person]{}, err
+	}
+
+	// Expand relations only for the people on this page
+	if err := s.expand(ctx, result.Results, q.Expand); err != nil {
+		return domain.PaginatedResponse[domain.Person]{}, err
+	}
+
+	return result, nil
+}
+
+// listPage filters and sorts the people of a single aggregated upstream page.
+func (s *PeopleService) listPage(ctx context.Context, q domain.ListQuery) (domain.PaginatedResponse[domain.Person], error) {
 	// Fetch from repository
-	result, err := s.repo.APIRetrievePeople(ctx, page, searchTerm)
+	result, err := s.repo.APIRetrievePeople(ctx, q.Page, s.settings.pageSize(q), q.Search)
 	if err != nil {
 		return domain.PaginatedResponse[domain.Person]{}, err
 	}
 
 	// Apply search filter
-	filtered, err := search.FilterPeopleByName(result.Results, searchTerm)
+	filtered, err := peopleSearcher(ctx, s.names)(result.Results, q)
 	if err != nil {
 		return domain.PaginatedResponse[domain.Person]{}, err
 	}
 
-	// Apply sorting if requested
-	if sortBy != "" {
-		sorter := sorting.NewPersonSorter(sortBy)
-		if sorter != nil {
-			ascending := sortOrder == "asc"
-			sorter.Sort(filtered, ascending)
-		}
+	// Apply filter expression and ranges
+	filtered, err = wherePeople(filtered, q)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Person]{}, err
 	}
 
+	// Apply sorting if requested
+	sorting.NewChain(q.Sort, sorting.NewPersonSorter).Sort(filtered)
+
 	// Update results with filtered and sorted data
 	result.Results = filtered
 	return result, nil
 }
 
-// GetPeopleByID fetches a single person by ID.
-func (s *PeopleService) GetPeopleByID(ctx context.Context, id string) (domain.Person, error) {
-	return s.repo.APIRetrievePersonByID(ctx, id)
+// listCollection filters, sorts and paginates the complete (cached) people collection.
+func (s *PeopleService) listCollection(ctx context.Context, q domain.ListQuery) (domain.PaginatedResponse[domain.Person], error) {
+	people, err := s.collection.Get(ctx)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Person]{}, err
+	}
+
+	return listInMemory(people, q, s.settings.pageSize(q), peopleSearcher(ctx, s.names), wherePeople, sorting.NewPersonSorter)
+}
+
+// GetPeopleByID fetches a single person by ID with optional relation expansion.
+func (s *PeopleService) GetPeopleByID(ctx context.Context, id string, expand []string) (domain.Person, error) {
+	person, err := s.repo.APIRetrievePersonByID(ctx, id)
+	if err != nil {
+		return domain.Person{}, err
+	}
+
+	people := []domain.Person{person}
+	if err := s.expand(ctx, people, expand); err != nil {
+		return domain.Person{}, err
+	}
+
+	return people[0], nil
+}
+
+// ListPersonFilms resolves the films a person appears in, then searches, sorts and paginates them.
+func (s *PeopleService) ListPersonFilms(ctx context.Context, personID string, q domain.ListQuery) (domain.PaginatedResponse[domain.Film], error) {
+	if s.resolver == nil {
+		return domain.PaginatedResponse[domain.Film]{}, errResolverNotConfigured
+	}
+
+	person, err := s.repo.APIRetrievePersonByID(ctx, personID)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Film]{}, err
+	}
+
+	films, err := s.resolver.ResolveFilms(ctx, person.Films)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Film]{}, err
+	}
+
+	return listInMemory(films, q, s.settings.pageSize(q), searchFilms, whereFilms, sorting.NewFilmSorter)
+}
+
+// expand resolves the requested relations in place when a resolver is configured.
+func (s *PeopleService) expand(ctx context.Context, people []domain.Person, relations []string) error {
+	if s.resolver == nil {
+		return nil
+	}
+	return s.resolver.ExpandPeople(ctx, people, relations)
 }
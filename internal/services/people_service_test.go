@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stressedbypull/swapi-connector/internal/domain"
 	errDomain "github.com/stressedbypull/swapi-connector/internal/errors"
 	"github.com/stressedbypull/swapi-connector/internal/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPeopleService_ListPeople(t *testing.T) {
@@ -43,8 +45,8 @@ func TestPeopleService_ListPeople(t *testing.T) {
 				Page:     1,
 				PageSize: 2,
 				Results: []domain.Person{
-					{Name: "Luke Skywalker", Mass: 77, Create: "2024-01-01"},
-					{Name: "Darth Vader", Mass: 136, Create: "2024-01-02"},
+					{Name: "Luke Skywalker", Mass: domain.Measure(77), Create: "2024-01-01"},
+					{Name: "Darth Vader", Mass: domain.Measure(136), Create: "2024-01-02"},
 				},
 			},
 			mockError:    nil,
@@ -67,9 +69,9 @@ func TestPeopleService_ListPeople(t *testing.T) {
 				Page:     1,
 				PageSize: 3,
 				Results: []domain.Person{
-					{Name: "Luke Skywalker", Mass: 77, Create: "2024-01-01"},
-					{Name: "Darth Vader", Mass: 136, Create: "2024-01-02"},
-					{Name: "Anakin Skywalker", Mass: 84, Create: "2024-01-03"},
+					{Name: "Luke Skywalker", Mass: domain.Measure(77), Create: "2024-01-01"},
+					{Name: "Darth Vader", Mass: domain.Measure(136), Create: "2024-01-02"},
+					{Name: "Anakin Skywalker", Mass: domain.Measure(84), Create: "2024-01-03"},
 				},
 			},
 			mockError:    nil,
@@ -93,9 +95,9 @@ func TestPeopleService_ListPeople(t *testing.T) {
 				Page:     1,
 				PageSize: 3,
 				Results: []domain.Person{
-					{Name: "Luke Skywalker", Mass: 77, Create: "2024-01-01"},
-					{Name: "Darth Vader", Mass: 136, Create: "2024-01-02"},
-					{Name: "Leia Organa", Mass: 49, Create: "2024-01-03"},
+					{Name: "Luke Skywalker", Mass: domain.Measure(77), Create: "2024-01-01"},
+					{Name: "Darth Vader", Mass: domain.Measure(136), Create: "2024-01-02"},
+					{Name: "Leia Organa", Mass: domain.Measure(49), Create: "2024-01-03"},
 				},
 			},
 			mockError:    nil,
@@ -103,9 +105,9 @@ func TestPeopleService_ListPeople(t *testing.T) {
 			wantError:    false,
 			wantCount:    3,
 			validateResults: func(t *testing.T, results []domain.Person) {
-				assert.Equal(t, 136, results[0].Mass, "First should be heaviest")
-				assert.Equal(t, 77, results[1].Mass, "Second should be medium")
-				assert.Equal(t, 49, results[2].Mass, "Third should be lightest")
+				assert.Equal(t, domain.Measure(136), results[0].Mass, "First should be heaviest")
+				assert.Equal(t, domain.Measure(77), results[1].Mass, "Second should be medium")
+				assert.Equal(t, domain.Measure(49), results[2].Mass, "Third should be lightest")
 			},
 		},
 		{
@@ -119,9 +121,9 @@ func TestPeopleService_ListPeople(t *testing.T) {
 				Page:     1,
 				PageSize: 3,
 				Results: []domain.Person{
-					{Name: "Person2", Mass: 80, Create: date2},
-					{Name: "Person3", Mass: 90, Create: date3},
-					{Name: "Person1", Mass: 70, Create: date1},
+					{Name: "Person2", Mass: domain.Measure(80), Create: date2},
+					{Name: "Person3", Mass: domain.Measure(90), Create: date3},
+					{Name: "Person1", Mass: domain.Measure(70), Create: date1},
 				},
 			},
 			mockError:    nil,
@@ -210,8 +212,8 @@ func TestPeopleService_ListPeople(t *testing.T) {
 				Page:     1,
 				PageSize: 2,
 				Results: []domain.Person{
-					{Name: "Zulu", Mass: 77, Create: "2024-01-01"},
-					{Name: "Alpha", Mass: 136, Create: "2024-01-02"},
+					{Name: "Zulu", Mass: domain.Measure(77), Create: "2024-01-01"},
+					{Name: "Alpha", Mass: domain.Measure(136), Create: "2024-01-02"},
 				},
 			},
 			mockError:    nil,
@@ -235,8 +237,8 @@ func TestPeopleService_ListPeople(t *testing.T) {
 				Page:     1,
 				PageSize: 2,
 				Results: []domain.Person{
-					{Name: "Zulu", Mass: 77, Create: "2024-01-01"},
-					{Name: "Alpha", Mass: 136, Create: "2024-01-02"},
+					{Name: "Zulu", Mass: domain.Measure(77), Create: "2024-01-01"},
+					{Name: "Alpha", Mass: domain.Measure(136), Create: "2024-01-02"},
 				},
 			},
 			mockError:    nil,
@@ -256,12 +258,12 @@ func TestPeopleService_ListPeople(t *testing.T) {
 			// Arrange
 			ctx := tt.setupContext()
 			mockRepo := mocks.NewMockSwapiRepository()
-			mockRepo.On("APIRetrievePeople", mock.Anything, tt.page, tt.searchTerm).
+			mockRepo.On("APIRetrievePeople", mock.Anything, tt.page, 15, tt.searchTerm).
 				Return(tt.mockResponse, tt.mockError)
-			service := NewPeopleService(mockRepo)
+			service := NewPeopleService(mockRepo, NewCollectionCache(0, mockRepo.APIRetrieveAllPeople), nil, nil, ListSettings{PageSize: 15})
 
 			// Act
-			result, err := service.ListPeople(ctx, tt.page, tt.searchTerm, tt.sortBy, tt.sortOrder)
+			result, err := service.ListPeople(ctx, domain.ListQuery{Page: tt.page, Search: tt.searchTerm, Sort: sortKeys(tt.sortBy, tt.sortOrder)})
 
 			// Assert
 			if tt.wantError {
@@ -298,7 +300,7 @@ func TestPeopleService_GetPeopleByID(t *testing.T) {
 			personID: "1",
 			mockPerson: domain.Person{
 				Name:   "Luke Skywalker",
-				Mass:   77,
+				Mass:   domain.Measure(77),
 				Create: "2014-12-09",
 				Films:  []string{"film1", "film2"},
 			},
@@ -306,7 +308,7 @@ func TestPeopleService_GetPeopleByID(t *testing.T) {
 			wantError: false,
 			validateResult: func(t *testing.T, person domain.Person) {
 				assert.Equal(t, "Luke Skywalker", person.Name)
-				assert.Equal(t, 77, person.Mass)
+				assert.Equal(t, domain.Measure(77), person.Mass)
 				assert.Equal(t, 2, len(person.Films))
 			},
 		},
@@ -346,10 +348,10 @@ func TestPeopleService_GetPeopleByID(t *testing.T) {
 			mockRepo := mocks.NewMockSwapiRepository()
 			mockRepo.On("APIRetrievePersonByID", ctx, tt.personID).
 				Return(tt.mockPerson, tt.mockError)
-			service := NewPeopleService(mockRepo)
+			service := NewPeopleService(mockRepo, NewCollectionCache(0, mockRepo.APIRetrieveAllPeople), nil, nil, ListSettings{PageSize: 15})
 
 			// Act
-			result, err := service.GetPeopleByID(ctx, tt.personID)
+			result, err := service.GetPeopleByID(ctx, tt.personID, nil)
 
 			// Assert
 			if tt.wantError {
@@ -369,3 +371,265 @@ func TestPeopleService_GetPeopleByID(t *testing.T) {
 		})
 	}
 }
+
+func TestPeopleService_ListPersonFilms(t *testing.T) {
+	ctx := context.Background()
+	luke := domain.Person{
+		Name: "Luke Skywalker",
+		Films: []string{
+			"https://swapi.dev/api/films/1/",
+			"https://swapi.dev/api/films/2/",
+			"https://swapi.dev/api/films/3/",
+		},
+	}
+
+	mockRepo := mocks.NewMockSwapiRepository()
+	mockRepo.On("APIRetrievePersonByID", mock.Anything, "1").Return(luke, nil)
+	mockRepo.On("FetchFilmByID", mock.Anything, "1").Return(domain.Film{Title: "A New Hope", EpisodeID: 4}, nil)
+	mockRepo.On("FetchFilmByID", mock.Anything, "2").Return(domain.Film{Title: "The Empire Strikes Back", EpisodeID: 5}, nil)
+	mockRepo.On("FetchFilmByID", mock.Anything, "3").Return(domain.Film{Title: "Return of the Jedi", EpisodeID: 6}, nil)
+	resolver := NewRelationResolver(mockRepo, mockRepo, mockRepo, 10, 2)
+	service := NewPeopleService(mockRepo, NewCollectionCache(0, mockRepo.APIRetrieveAllPeople), resolver, nil, ListSettings{PageSize: 2})
+
+	result, err := service.ListPersonFilms(ctx, "1", domain.ListQuery{Page: 1, Sort: []domain.SortKey{{Field: "episode", Descending: true}}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result.Count)
+	assert.Equal(t, 2, result.PageSize)
+	assert.Equal(t, "Return of the Jedi", result.Results[0].Title)
+	assert.Equal(t, "The Empire Strikes Back", result.Results[1].Title)
+
+	_, err = service.ListPersonFilms(ctx, "1", domain.ListQuery{Page: 1, Search: "phantom"})
+	assert.ErrorIs(t, err, errDomain.ErrFilmNotFound)
+}
+
+func TestPeopleService_ListPeople_CollectionMode(t *testing.T) {
+	ctx := context.Background()
+	everyone := []domain.Person{
+		{Name: "Luke Skywalker", Mass: domain.Measure(77)},
+		{Name: "Darth Vader", Mass: domain.Measure(136)},
+		{Name: "Leia Organa", Mass: domain.Measure(49)},
+		{Name: "Jabba Desilijic Tiure", Mass: domain.Measure(1358)},
+		{Name: "Yoda", Mass: domain.Measure(17)},
+	}
+
+	tests := []struct {
+		name      string
+		query     domain.ListQuery
+		wantCount int
+		wantNames []string
+	}{
+		{
+			name:      "sorts the whole collection before paginating",
+			query:     domain.ListQuery{Page: 1, Sort: []domain.SortKey{{Field: "mass", Descending: true}}, Mode: domain.ListModeCollection},
+			wantCount: 5,
+			wantNames: []string{"Jabba Desilijic Tiure", "Darth Vader"},
+		},
+		{
+			name:      "second page continues the global order",
+			query:     domain.ListQuery{Page: 2, Sort: []domain.SortKey{{Field: "mass", Descending: true}}, Mode: domain.ListModeCollection},
+			wantCount: 5,
+			wantNames: []string{"Luke Skywalker", "Leia Organa"},
+		},
+		{
+			name:      "count reflects the filtered collection",
+			query:     domain.ListQuery{Page: 1, Search: "d", Sort: []domain.SortKey{{Field: "name"}}, Mode: domain.ListModeCollection},
+			wantCount: 3,
+			wantNames: []string{"Darth Vader", "Jabba Desilijic Tiure"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return(everyone, nil).Once()
+			service := NewPeopleService(mockRepo, NewCollectionCache(time.Minute, mockRepo.APIRetrieveAllPeople), nil, nil, ListSettings{PageSize: 2})
+
+			result, err := service.ListPeople(ctx, tt.query)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCount, result.Count)
+			assert.Equal(t, tt.query.Page, result.Page)
+			names := make([]string, len(result.Results))
+			for i, person := range result.Results {
+				names[i] = person.Name
+			}
+			assert.Equal(t, tt.wantNames, names)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPeopleService_ListPeople_DefaultMode(t *testing.T) {
+	mockRepo := mocks.NewMockSwapiRepository()
+	mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{{Name: "Yoda"}}, nil).Once()
+	service := NewPeopleService(mockRepo, NewCollectionCache(time.Minute, mockRepo.APIRetrieveAllPeople), nil, nil, ListSettings{PageSize: 2, DefaultMode: domain.ListModeCollection})
+
+	// Both requests use the configured default and share one upstream load
+	for range 2 {
+		result, err := service.ListPeople(context.Background(), domain.ListQuery{Page: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.Count)
+	}
+
+	mockRepo.AssertNotCalled(t, "APIRetrievePeople", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPeopleService_ListPeople_Fuzzy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("page mode searches the whole collection and ranks by relevance", func(t *testing.T) {
+		mockRepo := mocks.NewMockSwapiRepository()
+		// SWAPI cannot do fuzzy search, so the complete collection is scored instead of one upstream page
+		mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{
+			{Name: "Shmi Skywalker"},
+			{Name: "Darth Vader"},
+			{Name: "Luke Skywalker"},
+			{Name: "Yoda"},
+		}, nil)
+		service := NewPeopleService(mockRepo, NewCollectionCache(0, mockRepo.APIRetrieveAllPeople), nil, nil, ListSettings{PageSize: 2, DefaultMode: domain.ListModePage})
+
+		result, err := service.ListPeople(ctx, domain.ListQuery{
+			Page: 1, Search: "luke skywlker", Match: domain.MatchFuzzy, Threshold: 0.4, Sort: []domain.SortKey{{Field: "relevance"}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result.Count)
+		assert.Len(t, result.Results, 2)
+		assert.Equal(t, "Luke Skywalker", result.Results[0].Name)
+		assert.Equal(t, "Shmi Skywalker", result.Results[1].Name)
+		assert.Greater(t, result.Results[0].Relevance, result.Results[1].Relevance)
+
+		// Matches beyond the first upstream page are found too
+		result, err = service.ListPeople(ctx, domain.ListQuery{Page: 1, Search: "yoda", Match: domain.MatchFuzzy})
+		assert.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		assert.Equal(t, "Yoda", result.Results[0].Name)
+
+		mockRepo.AssertNotCalled(t, "APIRetrievePeople", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("threshold controls strictness", func(t *testing.T) {
+		mockRepo := mocks.NewMockSwapiRepository()
+		mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{{Name: "Luke Skywalker"}}, nil)
+		service := NewPeopleService(mockRepo, NewCollectionCache(time.Minute, mockRepo.APIRetrieveAllPeople), nil, nil, ListSettings{PageSize: 15})
+
+		_, err := service.ListPeople(ctx, domain.ListQuery{
+			Page: 1, Search: "skywlker", Match: domain.MatchFuzzy, Threshold: 0.95, Mode: domain.ListModeCollection,
+		})
+
+		assert.ErrorIs(t, err, errDomain.ErrPersonNotFound)
+	})
+}
+
+func TestPeopleService_ListPeople_SearchIn(t *testing.T) {
+	ctx := context.Background()
+	everyone := []domain.Person{
+		{Name: "Luke Skywalker", HomeworldID: "1"},
+		{Name: "Leia Organa", HomeworldID: "2"},
+		{Name: "Owen Lars", HomeworldID: "1"},
+	}
+
+	mockRepo := mocks.NewMockSwapiRepository()
+	mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return(everyone, nil)
+	mockRepo.On("FetchAllPlanets", mock.Anything).Return([]domain.Planet{
+		{ID: "1", Name: "Tatooine"},
+		{ID: "2", Name: "Alderaan"},
+	}, nil).Once()
+	names := NewRelatedNames(
+		NewCollectionCache(time.Minute, mockRepo.FetchAllPlanets),
+		NewCollectionCache(time.Minute, mockRepo.FetchAllFilms),
+		NewCollectionCache(time.Minute, mockRepo.FetchAllSpecies),
+	)
+	service := NewPeopleService(mockRepo, NewCollectionCache(time.Minute, mockRepo.APIRetrieveAllPeople), nil, names, ListSettings{PageSize: 15, DefaultMode: domain.ListModePage})
+
+	// Related names are searched across the whole collection, even in page mode
+	result, err := service.ListPeople(ctx, domain.ListQuery{
+		Page: 1, Search: "tatooine", SearchIn: []string{"name", "homeworld"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Count)
+	assert.Equal(t, "Luke Skywalker", result.Results[0].Name)
+	assert.Equal(t, "Owen Lars", result.Results[1].Name)
+
+	// Fuzzy matching also applies to related names
+	result, err = service.ListPeople(ctx, domain.ListQuery{
+		Page: 1, Search: "alderan", SearchIn: []string{"homeworld"}, Match: domain.MatchFuzzy, Mode: domain.ListModeCollection,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Count)
+	assert.Equal(t, "Leia Organa", result.Results[0].Name)
+	mockRepo.AssertNotCalled(t, "APIRetrievePeople", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+
+	// Without a lookup, related-name searches are refused rather than silently ignored
+	_, err = NewPeopleService(mockRepo, NewCollectionCache(0, mockRepo.APIRetrieveAllPeople), nil, nil, ListSettings{PageSize: 15}).ListPeople(ctx, domain.ListQuery{
+		Page: 1, Search: "tatooine", SearchIn: []string{"homeworld"}, Mode: domain.ListModeCollection,
+	})
+	assert.ErrorIs(t, err, errRelatedNamesNotConfigured)
+}
+
+func TestPeopleService_ListPeople_Ranges(t *testing.T) {
+	ctx := context.Background()
+	everyone := []domain.Person{
+		{Name: "Luke Skywalker", Mass: domain.Measure(77)},
+		{Name: "Darth Vader", Mass: domain.Measure(136)},
+		{Name: "Arvel Crynyd"},
+		{Name: "Yoda", Mass: domain.Measure(17)},
+	}
+	massMax := 80.0
+
+	tests := []struct {
+		name           string
+		includeUnknown bool
+		wantNames      []string
+	}{
+		{
+			name:      "unknown mass does not match massMax",
+			wantNames: []string{"Luke Skywalker", "Yoda"},
+		},
+		{
+			name:           "includeUnknown keeps unknown mass",
+			includeUnknown: true,
+			wantNames:      []string{"Luke Skywalker", "Arvel Crynyd", "Yoda"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return(everyone, nil).Once()
+			service := NewPeopleService(mockRepo, NewCollectionCache(time.Minute, mockRepo.APIRetrieveAllPeople), nil, nil, ListSettings{PageSize: 15, DefaultMode: domain.ListModePage})
+
+			// Ranges always cover the complete collection, even in page mode
+			result, err := service.ListPeople(ctx, domain.ListQuery{
+				Page: 1,
+				Ranges: domain.RangeFilter{
+					Numbers:        []domain.NumberRange{{Field: "mass", Max: &massMax}},
+					IncludeUnknown: tt.includeUnknown,
+				},
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.wantNames), result.Count)
+			names := make([]string, len(result.Results))
+			for i, person := range result.Results {
+				names[i] = person.Name
+			}
+			assert.Equal(t, tt.wantNames, names)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// sortKeys converts the single-field sortBy/sortOrder form used by the table tests.
+func sortKeys(sortBy, sortOrder string) []domain.SortKey {
+	if sortBy == "" {
+		return nil
+	}
+	return []domain.SortKey{{Field: sortBy, Descending: sortOrder == "desc"}}
+}
@@ -5,46 +5,132 @@ import (
 
 	"github.com/stressedbypull/swapi-connector/internal/domain"
 	"github.com/stressedbypull/swapi-connector/internal/ports"
-	"github.com/stressedbypull/swapi-connector/internal/search"
 	"github.com/stressedbypull/swapi-connector/internal/sorting"
 )
 
+// PlanetService handles business logic for planet operations.
 type PlanetService struct {
-	repo ports.PlanetsRepository
+	repo       ports.PlanetsRepository
+	resolver   *RelationResolver // Optional: nil disables ?expand= and nested collections
+	names      *RelatedNames     // Optional: nil disables searching residents' related names
+	settings   ListSettings
+	collection *CollectionCache[domain.Planet] // Complete planets collection for whole-collection mode
 }
 
-func NewPlanetService(r ports.PlanetsRepository) *PlanetService {
-	return &PlanetService{repo: r}
+// NewPlanetService creates a new planet service with dependency injection.
+// collection is the shared cache of the complete planets collection.
+// The resolver may be nil, in which case expand requests are ignored.
+func NewPlanetService(r ports.PlanetsRepository, collection *CollectionCache[domain.Planet], resolver *RelationResolver, names *RelatedNames, settings ListSettings) *PlanetService {
+	return &PlanetService{
+		repo:       r,
+		resolver:   resolver,
+		names:      names,
+		settings:   settings,
+		collection: collection,
+	}
 }
 
-// ListPlanets fetches a paginated list of planets with search and sorting.
-func (s *PlanetService) ListPlanets(ctx context.Context, page int, searchTerm, sortBy, sortOrder string) (domain.PaginatedResponse[domain.Planet], error) {
+// ListPlanets fetches a paginated list of planets with search, sorting and optional relation expansion.
+// In page mode only the requested upstream page is filtered and sorted; in collection mode
+// the complete collection is filtered and sorted before it is paginated.
+func (s *PlanetService) ListPlanets(ctx context.Context, q domain.ListQuery) (domain.PaginatedResponse[domain.Planet], error) {
+	var (
+		result domain.PaginatedResponse[domain.Planet]
+		err    error
+	)
+
+	if s.settings.mode(q) == domain.ListModeCollection {
+		result, err = s.listCollection(ctx, q)
+	} else {
+		result, err = s.listPage(ctx, q)
+	}
+	if err != nil {
+		return domain.PaginatedResponse[domain.Planet]{}, err
+	}
+
+	// Expand relations only for the planets on this page
+	if err := s.expand(ctx, result.Results, q.Expand); err != nil {
+		return domain.PaginatedResponse[domain.Planet]{}, err
+	}
+
+	return result, nil
+}
+
+// listPage filters and sorts the planets of a single aggregated upstream page.
+func (s *PlanetService) listPage(ctx context.Context, q domain.ListQuery) (domain.PaginatedResponse[domain.Planet], error) {
 	// Fetch from repository
-	result, err := s.repo.FetchPlanets(ctx, page, searchTerm)
+	result, err := s.repo.FetchPlanets(ctx, q.Page, s.settings.pageSize(q), q.Search)
 	if err != nil {
 		return domain.PaginatedResponse[domain.Planet]{}, err
 	}
 
 	// Apply search filter
-	filtered, err := search.FilterPlanetsByName(result.Results, searchTerm)
+	filtered, err := searchPlanets(result.Results, q)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Planet]{}, err
+	}
+
+	// Apply filter expression and ranges
+	filtered, err = wherePlanets(filtered, q)
 	if err != nil {
 		return domain.PaginatedResponse[domain.Planet]{}, err
 	}
 	result.Results = filtered
 
 	// Apply sorting if requested
-	if sortBy != "" {
-		sorter := sorting.NewPlanetSorter(sortBy)
-		if sorter != nil {
-			ascending := sortOrder == "asc"
-			sorter.Sort(result.Results, ascending)
-		}
-	}
+	sorting.NewChain(q.Sort, sorting.NewPlanetSorter).Sort(result.Results)
 
 	return result, nil
 }
 
-// GetPlanetByID fetches a single planet by ID.
-func (s *PlanetService) GetPlanetByID(ctx context.Context, id string) (domain.Planet, error) {
-	return s.repo.FetchPlanetByID(ctx, id)
+// listCollection filters, sorts and paginates the complete (cached) planets collection.
+func (s *PlanetService) listCollection(ctx context.Context, q domain.ListQuery) (domain.PaginatedResponse[domain.Planet], error) {
+	planets, err := s.collection.Get(ctx)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Planet]{}, err
+	}
+
+	return listInMemory(planets, q, s.settings.pageSize(q), searchPlanets, wherePlanets, sorting.NewPlanetSorter)
+}
+
+// GetPlanetByID fetches a single planet by ID with optional relation expansion.
+func (s *PlanetService) GetPlanetByID(ctx context.Context, id string, expand []string) (domain.Planet, error) {
+	planet, err := s.repo.FetchPlanetByID(ctx, id)
+	if err != nil {
+		return domain.Planet{}, err
+	}
+
+	planets := []domain.Planet{planet}
+	if err := s.expand(ctx, planets, expand); err != nil {
+		return domain.Planet{}, err
+	}
+
+	return planets[0], nil
+}
+
+// ListPlanetResidents resolves the people living on a planet, then searches, sorts and paginates them.
+func (s *PlanetService) ListPlanetResidents(ctx context.Context, planetID string, q domain.ListQuery) (domain.PaginatedResponse[domain.Person], error) {
+	if s.resolver == nil {
+		return domain.PaginatedResponse[domain.Person]{}, errResolverNotConfigured
+	}
+
+	planet, err := s.repo.FetchPlanetByID(ctx, planetID)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Person]{}, err
+	}
+
+	residents, err := s.resolver.ResolvePeople(ctx, planet.Resident)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Person]{}, err
+	}
+
+	return listInMemory(residents, q, s.settings.pageSize(q), peopleSearcher(ctx, s.names), wherePeople, sorting.NewPersonSorter)
+}
+
+// expand resolves the requested relations in place when a resolver is configured.
+func (s *PlanetService) expand(ctx context.Context, planets []domain.Planet, relations []string) error {
+	if s.resolver == nil {
+		return nil
+	}
+	return s.resolver.ExpandPlanets(ctx, planets, relations)
 }
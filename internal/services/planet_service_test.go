@@ -0,0 +1,254 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	errDomain "github.com/stressedbypull/swapi-connector/internal/errors"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPlanetService_ListPlanets(t *testing.T) {
+	planets := domain.PaginatedResponse[domain.Planet]{
+		Count:    3,
+		Page:     1,
+		PageSize: 3,
+		Results: []domain.Planet{
+			{Name: "Tatooine", Created: "2014-12-09"},
+			{Name: "Alderaan", Created: "2014-12-10"},
+			{Name: "Yavin IV", Created: "2014-12-08"},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		searchTerm        string
+		sortBy            string
+		sortOrder         string
+		mockResponse      domain.PaginatedResponse[domain.Planet]
+		mockError         error
+		wantError         bool
+		wantErrorContains string
+		wantNames         []string
+	}{
+		{
+			name:         "Success - Sort by name ascending",
+			sortBy:       "name",
+			sortOrder:    "asc",
+			mockResponse: planets,
+			wantNames:    []string{"Alderaan", "Tatooine", "Yavin IV"},
+		},
+		{
+			name:         "Success - Sort by created descending",
+			sortBy:       "created",
+			sortOrder:    "desc",
+			mockResponse: planets,
+			wantNames:    []string{"Alderaan", "Tatooine", "Yavin IV"},
+		},
+		{
+			name:         "Success - Search filter",
+			searchTerm:   "tato",
+			sortOrder:    "asc",
+			mockResponse: planets,
+			wantNames:    []string{"Tatooine"},
+		},
+		{
+			name:              "Error - Search with no results returns 404",
+			searchTerm:        "naboo",
+			sortOrder:         "asc",
+			mockResponse:      planets,
+			wantError:         true,
+			wantErrorContains: "not found",
+		},
+		{
+			name:              "Error - SWAPI Unavailable",
+			sortOrder:         "asc",
+			mockResponse:      domain.PaginatedResponse[domain.Planet]{},
+			mockError:         errors.New("connection refused"),
+			wantError:         true,
+			wantErrorContains: "connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange: copy results so sorting doesn't leak between cases
+			resp := tt.mockResponse
+			resp.Results = append([]domain.Planet(nil), tt.mockResponse.Results...)
+
+			mockRepo := mocks.NewMockSwapiRepository()
+			mockRepo.On("FetchPlanets", mock.Anything, 1, 15, tt.searchTerm).Return(resp, tt.mockError)
+			service := NewPlanetService(mockRepo, NewCollectionCache(0, mockRepo.FetchAllPlanets), nil, nil, ListSettings{PageSize: 15})
+
+			// Act
+			result, err := service.ListPlanets(context.Background(), domain.ListQuery{Page: 1, Search: tt.searchTerm, Sort: sortKeys(tt.sortBy, tt.sortOrder)})
+
+			// Assert
+			if tt.wantError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrorContains)
+				assert.Empty(t, result.Results)
+			} else {
+				assert.NoError(t, err)
+				names := make([]string, 0, len(result.Results))
+				for _, planet := range result.Results {
+					names = append(names, planet.Name)
+				}
+				assert.Equal(t, tt.wantNames, names)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlanetService_GetPlanetByID(t *testing.T) {
+	tests := []struct {
+		name       string
+		planetID   string
+		mockPlanet domain.Planet
+		mockError  error
+		wantError  bool
+	}{
+		{
+			name:       "Success - Get Planet By ID",
+			planetID:   "1",
+			mockPlanet: domain.Planet{Name: "Tatooine"},
+		},
+		{
+			name:      "Error - Planet Not Found",
+			planetID:  "9999",
+			mockError: errDomain.ErrPlanetNotFound,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockRepo := mocks.NewMockSwapiRepository()
+			mockRepo.On("FetchPlanetByID", ctx, tt.planetID).Return(tt.mockPlanet, tt.mockError)
+			service := NewPlanetService(mockRepo, NewCollectionCache(0, mockRepo.FetchAllPlanets), nil, nil, ListSettings{PageSize: 15})
+
+			result, err := service.GetPlanetByID(ctx, tt.planetID, nil)
+
+			if tt.wantError {
+				assert.ErrorIs(t, err, tt.mockError)
+				assert.Empty(t, result.Name)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.mockPlanet.Name, result.Name)
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlanetService_ListPlanetResidents(t *testing.T) {
+	tatooine := domain.Planet{
+		Name: "Tatooine",
+		Resident: []string{
+			"https://swapi.dev/api/people/1/",
+			"https://swapi.dev/api/people/4/",
+			"https://swapi.dev/api/people/5/",
+		},
+	}
+	residents := map[string]domain.Person{
+		"1": {Name: "Luke Skywalker", Mass: domain.Measure(77)},
+		"4": {Name: "Darth Vader", Mass: domain.Measure(136)},
+		"5": {Name: "Owen Lars", Mass: domain.Measure(120)},
+	}
+
+	tests := []struct {
+		name       string
+		page       int
+		pageSize   int
+		searchTerm string
+		sortBy     string
+		sortOrder  string
+		planetErr  error
+		wantError  error
+		wantCount  int
+		wantNames  []string
+	}{
+		{
+			name:      "Success - Sorted by mass descending",
+			page:      1,
+			pageSize:  15,
+			sortBy:    "mass",
+			sortOrder: "desc",
+			wantCount: 3,
+			wantNames: []string{"Darth Vader", "Owen Lars", "Luke Skywalker"},
+		},
+		{
+			name:      "Success - Second page after sorting",
+			page:      2,
+			pageSize:  2,
+			sortBy:    "name",
+			sortOrder: "asc",
+			wantCount: 3,
+			wantNames: []string{"Owen Lars"},
+		},
+		{
+			name:       "Success - Search filters residents",
+			page:       1,
+			pageSize:   15,
+			searchTerm: "sky",
+			sortOrder:  "asc",
+			wantCount:  1,
+			wantNames:  []string{"Luke Skywalker"},
+		},
+		{
+			name:      "Error - Planet not found",
+			page:      1,
+			pageSize:  15,
+			planetErr: errDomain.ErrPlanetNotFound,
+			wantError: errDomain.ErrPlanetNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			mockRepo.On("FetchPlanetByID", mock.Anything, "1").Return(tatooine, tt.planetErr)
+			if tt.planetErr == nil {
+				for id, person := range residents {
+					mockRepo.On("APIRetrievePersonByID", mock.Anything, id).Return(person, nil).Once()
+				}
+			}
+			resolver := NewRelationResolver(mockRepo, mockRepo, mockRepo, 10, 2)
+			service := NewPlanetService(mockRepo, NewCollectionCache(0, mockRepo.FetchAllPlanets), resolver, nil, ListSettings{PageSize: tt.pageSize})
+
+			result, err := service.ListPlanetResidents(context.Background(), "1", domain.ListQuery{Page: tt.page, Search: tt.searchTerm, Sort: sortKeys(tt.sortBy, tt.sortOrder)})
+
+			if tt.wantError != nil {
+				assert.ErrorIs(t, err, tt.wantError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCount, result.Count)
+			assert.Equal(t, tt.page, result.Page)
+			names := make([]string, len(result.Results))
+			for i, person := range result.Results {
+				names[i] = person.Name
+			}
+			assert.Equal(t, tt.wantNames, names)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPlanetService_ListPlanetResidents_NoResolver(t *testing.T) {
+	mockRepo := mocks.NewMockSwapiRepository()
+	service := NewPlanetService(mockRepo, NewCollectionCache(0, mockRepo.FetchAllPlanets), nil, nil, ListSettings{PageSize: 15})
+
+	_, err := service.ListPlanetResidents(context.Background(), "1", domain.ListQuery{Page: 1})
+
+	assert.Error(t, err)
+}
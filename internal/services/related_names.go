@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	stderrors "errors"
+	"slices"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+// Fields accepted by the ?searchIn= query parameter.
+const (
+	SearchInName      = "name"
+	SearchInHomeworld = "homeworld"
+	SearchInFilms     = "films"
+	SearchInSpecies   = "species"
+)
+
+// errRelatedNamesNotConfigured is returned when a search targets related names but the service has no lookup.
+var errRelatedNamesNotConfigured = stderrors.New("related names lookup not configured")
+
+// RelatedNames resolves related resource IDs (homeworld, films, species) to display names.
+// The planet, film and species collections come from the shared collection caches,
+// so searching related names does not fan out to SWAPI on every request.
+type RelatedNames struct {
+	planets *CollectionCache[domain.Planet]
+	films   *CollectionCache[domain.Film]
+	species *CollectionCache[domain.Species]
+}
+
+// NewRelatedNames creates a related names lookup over the shared collection caches.
+func NewRelatedNames(planets *CollectionCache[domain.Planet], films *CollectionCache[domain.Film], species *CollectionCache[domain.Species]) *RelatedNames {
+	return &RelatedNames{
+		planets: planets,
+		films:   films,
+		species: species,
+	}
+}
+
+// PersonTexts returns a function listing the searchable texts of a person for the
+// requested fields, e.g. [name, homeworld name, film titles...].
+// Only the collections those fields need are loaded.
+func (n *RelatedNames) PersonTexts(ctx context.Context, fields []string) (func(domain.Person) []string, error) {
+	var (
+		planetNames  map[string]string
+		filmTitles   map[string]string
+		speciesNames map[string]string
+		err          error
+	)
+
+	if slices.Contains(fields, SearchInHomeworld) {
+		if planetNames, err = namesByID(ctx, n.planets, domain.Planet.GetName, idOfPlanet); err != nil {
+			return nil, err
+		}
+	}
+	if slices.Contains(fields, SearchInFilms) {
+		if filmTitles, err = namesByID(ctx, n.films, domain.Film.GetName, idOfFilm); err != nil {
+			return nil, err
+		}
+	}
+	if slices.Contains(fields, SearchInSpecies) {
+		if speciesNames, err = namesByID(ctx, n.species, domain.Species.GetName, idOfSpecies); err != nil {
+			return nil, err
+		}
+	}
+
+	withName := slices.Contains(fields, SearchInName)
+	return func(p domain.Person) []string {
+		var texts []string
+		if withName {
+			texts = append(texts, p.Name)
+		}
+		if planetNames != nil {
+			texts = appendNames(texts, planetNames, p.HomeworldID)
+		}
+		if filmTitles != nil {
+			texts = appendNames(texts, filmTitles, p.FilmIDs...)
+		}
+		if speciesNames != nil {
+			texts = appendNames(texts, speciesNames, p.SpeciesIDs...)
+		}
+		return texts
+	}, nil
+}
+
+func idOfPlanet(p domain.Planet) string   { return p.ID }
+func idOfFilm(f domain.Film) string       { return f.ID }
+func idOfSpecies(s domain.Species) string { return s.ID }
+
+// namesByID loads a cached collection and indexes its names by resource ID.
+func namesByID[T any](ctx context.Context, cache *CollectionCache[T], name, id func(T) string) (map[string]string, error) {
+	items, err := cache.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(items))
+	for _, item := range items {
+		names[id(item)] = name(item)
+	}
+	return names, nil
+}
+
+// appendNames appends the names known for ids, skipping unknown IDs.
+func appendNames(texts []string, names map[string]string, ids ...string) []string {
+	for _, id := range ids {
+		if name, ok := names[id]; ok {
+			texts = append(texts, name)
+		}
+	}
+	return texts
+}
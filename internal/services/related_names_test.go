@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelatedNames_PersonTexts(t *testing.T) {
+	ctx := context.Background()
+	luke := domain.Person{
+		Name:        "Luke Skywalker",
+		HomeworldID: "1",
+		FilmIDs:     []string{"1", "2"},
+		SpeciesIDs:  []string{"1"},
+	}
+
+	tests := []struct {
+		name      string
+		fields    []string
+		setupMock func(m *mocks.MockSwapiRepository)
+		want      []string
+	}{
+		{
+			name:   "homeworld only loads planets",
+			fields: []string{SearchInHomeworld},
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchAllPlanets", mock.Anything).Return([]domain.Planet{{ID: "1", Name: "Tatooine"}}, nil).Once()
+			},
+			want: []string{"Tatooine"},
+		},
+		{
+			name:   "name, films and species",
+			fields: []string{SearchInName, SearchInFilms, SearchInSpecies},
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchAllFilms", mock.Anything).Return([]domain.Film{
+					{ID: "1", Title: "A New Hope"},
+					{ID: "2", Title: "The Empire Strikes Back"},
+				}, nil).Once()
+				m.On("FetchAllSpecies", mock.Anything).Return([]domain.Species{{ID: "1", Name: "Human"}}, nil).Once()
+			},
+			want: []string{"Luke Skywalker", "A New Hope", "The Empire Strikes Back", "Human"},
+		},
+		{
+			name:   "unknown related IDs are skipped",
+			fields: []string{SearchInSpecies},
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchAllSpecies", mock.Anything).Return([]domain.Species{{ID: "2", Name: "Droid"}}, nil).Once()
+			},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			tt.setupMock(mockRepo)
+			names := NewRelatedNames(
+				NewCollectionCache(time.Minute, mockRepo.FetchAllPlanets),
+				NewCollectionCache(time.Minute, mockRepo.FetchAllFilms),
+				NewCollectionCache(time.Minute, mockRepo.FetchAllSpecies),
+			)
+
+			// Second lookup is served from the cache (mocks expect exactly one call)
+			for range 2 {
+				texts, err := names.PersonTexts(ctx, tt.fields)
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, texts(luke))
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package services
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/errors"
+	"github.com/stressedbypull/swapi-connector/internal/ports"
+)
+
+// Relation names accepted by the ?expand= query parameter.
+const (
+	RelationFilms     = "films"
+	RelationHomeworld = "homeworld"
+	RelationResidents = "residents"
+)
+
+// errResolverNotConfigured is returned by nested collection endpoints when the service was built without a resolver.
+var errResolverNotConfigured = stderrors.New("relation resolver not configured")
+
+// RelationResolver resolves related SWAPI resource URLs into embedded summaries.
+// Every call deduplicates URLs, refuses to trigger more than maxFetches upstream
+// calls and runs at most concurrency fetches in parallel.
+type RelationResolver struct {
+	people      ports.PeopleRepository
+	planets     ports.PlanetsRepository
+	films       ports.FilmsRepository
+	maxFetches  int
+	concurrency int
+}
+
+// NewRelationResolver creates a relation resolver with dependency injection.
+func NewRelationResolver(people ports.PeopleRepository, planets ports.PlanetsRepository, films ports.FilmsRepository, maxFetches, concurrency int) *RelationResolver {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &RelationResolver{
+		people:      people,
+		planets:     planets,
+		films:       films,
+		maxFetches:  maxFetches,
+		concurrency: concurrency,
+	}
+}
+
+// ExpandPeople resolves the requested relations (films, homeworld) for each person in place.
+func (r *RelationResolver) ExpandPeople(ctx context.Context, people []domain.Person, relations []string) error {
+	if len(relations) == 0 || len(people) == 0 {
+		return nil
+	}
+
+	want := relationSet(relations)
+	batch := newRelationBatch()
+	for _, person := range people {
+		if want[RelationFilms] {
+			batch.addFilms(person.Films...)
+		}
+		if want[RelationHomeworld] {
+			batch.addPlanets(person.Homeworld)
+		}
+	}
+
+	if err := r.resolve(ctx, batch); err != nil {
+		return err
+	}
+
+	for i := range people {
+		expanded := &domain.PersonRelations{}
+		if want[RelationFilms] {
+			expanded.Films = collect(people[i].Films, batch.films)
+		}
+		if want[RelationHomeworld] {
+			if homeworld, ok := batch.planets[people[i].Homeworld]; ok {
+				expanded.Homeworld = &homeworld
+			}
+		}
+		people[i].Expanded = expanded
+	}
+
+	return nil
+}
+
+// ExpandPlanets resolves the requested relations (residents, films) for each planet in place.
+func (r *RelationResolver) ExpandPlanets(ctx context.Context, planets []domain.Planet, relations []string) error {
+	if len(relations) == 0 || len(planets) == 0 {
+		return nil
+	}
+
+	want := relationSet(relations)
+	batch := newRelationBatch()
+	for _, planet := range planets {
+		if want[RelationResidents] {
+			batch.addPeople(planet.Resident...)
+		}
+		if want[RelationFilms] {
+			batch.addFilms(planet.Films...)
+		}
+	}
+
+	if err := r.resolve(ctx, batch); err != nil {
+		return err
+	}
+
+	for i := range planets {
+		expanded := &domain.PlanetRelations{}
+		if want[RelationResidents] {
+			expanded.Residents = collect(planets[i].Resident, batch.people)
+		}
+		if want[RelationFilms] {
+			expanded.Films = collect(planets[i].Films, batch.films)
+		}
+		planets[i].Expanded = expanded
+	}
+
+	return nil
+}
+
+// resolve fetches every URL in the batch concurrently.
+func (r *RelationResolver) resolve(ctx context.Context, batch *relationBatch) error {
+	if batch.size() == 0 {
+		return nil
+	}
+	if batch.size() > r.maxFetches {
+		return errors.ErrExpansionLimitExceeded
+	}
+
+	return r.run(ctx, func(pool *fetchPool) {
+		fetchAll(pool, batch.filmURLs, batch.films, r.films.FetchFilmByID, func(url string, film domain.Film) domain.FilmSummary {
+			return domain.FilmSummary{URL: url, Title: film.Title, EpisodeID: film.EpisodeID, ReleaseDate: film.ReleaseDate}
+		})
+		fetchAll(pool, batch.planetURLs, batch.planets, r.planets.FetchPlanetByID, func(url string, planet domain.Planet) domain.PlanetSummary {
+			return domain.PlanetSummary{URL: url, Name: planet.Name}
+		})
+		fetchAll(pool, batch.personURLs, batch.people, r.people.APIRetrievePersonByID, func(url string, person domain.Person) domain.PersonSummary {
+			return domain.PersonSummary{URL: url, Name: person.Name}
+		})
+	})
+}
+
+// ResolvePeople fetches the full person behind each URL, preserving order and dropping duplicates.
+func (r *RelationResolver) ResolvePeople(ctx context.Context, urls []string) ([]domain.Person, error) {
+	return resolveFull(ctx, r, urls, r.people.APIRetrievePersonByID)
+}
+
+// ResolveFilms fetches the full film behind each URL, preserving order and dropping duplicates.
+func (r *RelationResolver) ResolveFilms(ctx context.Context, urls []string) ([]domain.Film, error) {
+	return resolveFull(ctx, r, urls, r.films.FetchFilmByID)
+}
+
+// resolveFull fetches complete domain objects rather than summaries, under the same limits as expansion.
+func resolveFull[T any](ctx context.Context, r *RelationResolver, urls []string, fetch func(ctx context.Context, id string) (T, error)) ([]T, error) {
+	unique := newRelationBatch().appendUnique(nil, urls)
+	if len(unique) > r.maxFetches {
+		return nil, errors.ErrExpansionLimitExceeded
+	}
+
+	resolved := make(map[string]T, len(unique))
+	err := r.run(ctx, func(pool *fetchPool) {
+		fetchAll(pool, unique, resolved, fetch, func(_ string, item T) T { return item })
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return collect(unique, resolved), nil
+}
+
+// run executes the fetches scheduled by schedule with bounded concurrency and waits for them.
+// The first failure cancels the remaining fetches and is returned.
+func (r *RelationResolver) run(ctx context.Context, schedule func(pool *fetchPool)) error {
+	fetchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pool := &fetchPool{
+		ctx:    fetchCtx,
+		cancel: cancel,
+		sem:    make(chan struct{}, r.concurrency),
+	}
+
+	schedule(pool)
+	pool.wg.Wait()
+
+	if pool.err != nil {
+		return pool.err
+	}
+	// Parent context may have been cancelled before any fetch reported an error
+	return ctx.Err()
+}
+
+// fetchPool bounds concurrent fetches and records the first error.
+type fetchPool struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	sem    chan struct{}
+	wg     sync.WaitGroup
+	mu     sync.Mutex
+	err    error
+}
+
+// fetchAll schedules one fetch per URL and stores the summaries in out.
+func fetchAll[T, S any](pool *fetchPool, urls []string, out map[string]S, fetch func(ctx context.Context, id string) (T, error), summarize func(url string, item T) S) {
+	for _, url := range urls {
+		id, ok := domain.ResourceIDFromURL(url)
+		if !ok {
+			continue // Not a SWAPI resource URL; leave it unexpanded
+		}
+
+		pool.wg.Add(1)
+		go func(url, id string) {
+			defer pool.wg.Done()
+
+			select {
+			case pool.sem <- struct{}{}:
+				defer func() { <-pool.sem }()
+			case <-pool.ctx.Done():
+				return
+			}
+			if pool.ctx.Err() != nil {
+				return // Cancelled while waiting for a slot
+			}
+
+			item, err := fetch(pool.ctx, id)
+
+			pool.mu.Lock()
+			defer pool.mu.Unlock()
+			if err != nil {
+				if pool.err == nil {
+					pool.err = err
+					pool.cancel()
+				}
+				return
+			}
+			out[url] = summarize(url, item)
+		}(url, id)
+	}
+}
+
+// relationBatch collects the distinct URLs to resolve for one request and their results.
+type relationBatch struct {
+	seen       map[string]bool
+	filmURLs   []string
+	planetURLs []string
+	personURLs []string
+	films      map[string]domain.FilmSummary
+	planets    map[string]domain.PlanetSummary
+	people     map[string]domain.PersonSummary
+}
+
+func newRelationBatch() *relationBatch {
+	return &relationBatch{
+		seen:    make(map[string]bool),
+		films:   make(map[string]domain.FilmSummary),
+		planets: make(map[string]domain.PlanetSummary),
+		people:  make(map[string]domain.PersonSummary),
+	}
+}
+
+func (b *relationBatch) addFilms(urls ...string) {
+	b.filmURLs = b.appendUnique(b.filmURLs, urls)
+}
+
+func (b *relationBatch) addPlanets(urls ...string) {
+	b.planetURLs = b.appendUnique(b.planetURLs, urls)
+}
+
+func (b *relationBatch) addPeople(urls ...string) {
+	b.personURLs = b.appendUnique(b.personURLs, urls)
+}
+
+func (b *relationBatch) appendUnique(dst, urls []string) []string {
+	for _, url := range urls {
+		if url == "" || b.seen[url] {
+			continue
+		}
+		b.seen[url] = true
+		dst = append(dst, url)
+	}
+	return dst
+}
+
+// size returns the number of distinct upstream calls the batch requires.
+func (b *relationBatch) size() int {
+	return len(b.seen)
+}
+
+// collect returns the resolved summaries for urls, preserving their order.
+func collect[S any](urls []string, resolved map[string]S) []S {
+	summaries := make([]S, 0, len(urls))
+	for _, url := range urls {
+		if summary, ok := resolved[url]; ok {
+			summaries = append(summaries, summary)
+		}
+	}
+	return summaries
+}
+
+// relationSet converts the requested relation names to a lookup set.
+func relationSet(relations []string) map[string]bool {
+	set := make(map[string]bool, len(relations))
+	for _, relation := range relations {
+		set[relation] = true
+	}
+	return set
+}
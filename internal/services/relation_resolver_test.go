@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	errDomain "github.com/stressedbypull/swapi-connector/internal/errors"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	filmURL1   = "https://swapi.dev/api/films/1/"
+	filmURL2   = "https://swapi.dev/api/films/2/"
+	planetURL1 = "https://swapi.dev/api/planets/1/"
+	personURL1 = "https://swapi.dev/api/people/1/"
+)
+
+func TestRelationResolver_ExpandPeople(t *testing.T) {
+	tests := []struct {
+		name          string
+		relations     []string
+		maxFetches    int
+		setupMock     func(m *mocks.MockSwapiRepository)
+		wantError     error
+		wantFilms     [][]string
+		wantHomeworld []string
+	}{
+		{
+			name:       "Success - Shared films fetched once",
+			relations:  []string{RelationFilms},
+			maxFetches: 10,
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchFilmByID", mock.Anything, "1").Return(domain.Film{Title: "A New Hope", EpisodeID: 4}, nil).Once()
+				m.On("FetchFilmByID", mock.Anything, "2").Return(domain.Film{Title: "The Empire Strikes Back", EpisodeID: 5}, nil).Once()
+			},
+			wantFilms: [][]string{
+				{"A New Hope", "The Empire Strikes Back"},
+				{"A New Hope"},
+			},
+		},
+		{
+			name:       "Success - Homeworld only",
+			relations:  []string{RelationHomeworld},
+			maxFetches: 10,
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchPlanetByID", mock.Anything, "1").Return(domain.Planet{Name: "Tatooine"}, nil).Once()
+			},
+			wantHomeworld: []string{"Tatooine", "Tatooine"},
+		},
+		{
+			name:       "Error - Distinct URLs exceed fetch limit",
+			relations:  []string{RelationFilms, RelationHomeworld},
+			maxFetches: 2,
+			wantError:  errDomain.ErrExpansionLimitExceeded,
+		},
+		{
+			name:       "Error - Upstream failure is returned",
+			relations:  []string{RelationHomeworld},
+			maxFetches: 10,
+			setupMock: func(m *mocks.MockSwapiRepository) {
+				m.On("FetchPlanetByID", mock.Anything, "1").Return(domain.Planet{}, errDomain.ErrPlanetNotFound)
+			},
+			wantError: errDomain.ErrPlanetNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := mocks.NewMockSwapiRepository()
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+			resolver := NewRelationResolver(mockRepo, mockRepo, mockRepo, tt.maxFetches, 2)
+
+			people := []domain.Person{
+				{Name: "Luke Skywalker", Films: []string{filmURL1, filmURL2}, Homeworld: planetURL1},
+				{Name: "Owen Lars", Films: []string{filmURL1}, Homeworld: planetURL1},
+			}
+
+			err := resolver.ExpandPeople(context.Background(), people, tt.relations)
+
+			if tt.wantError != nil {
+				assert.ErrorIs(t, err, tt.wantError)
+				mockRepo.AssertExpectations(t)
+				return
+			}
+
+			require.NoError(t, err)
+			for i, person := range people {
+				require.NotNil(t, person.Expanded)
+				if tt.wantFilms != nil {
+					titles := make([]string, len(person.Expanded.Films))
+					for j, film := range person.Expanded.Films {
+						titles[j] = film.Title
+					}
+					assert.Equal(t, tt.wantFilms[i], titles)
+				}
+				if tt.wantHomeworld != nil {
+					require.NotNil(t, person.Expanded.Homeworld)
+					assert.Equal(t, tt.wantHomeworld[i], person.Expanded.Homeworld.Name)
+					assert.Equal(t, planetURL1, person.Expanded.Homeworld.URL)
+				}
+			}
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRelationResolver_ExpandPlanets(t *testing.T) {
+	mockRepo := mocks.NewMockSwapiRepository()
+	mockRepo.On("APIRetrievePersonByID", mock.Anything, "1").Return(domain.Person{Name: "Luke Skywalker"}, nil).Once()
+	mockRepo.On("FetchFilmByID", mock.Anything, "1").Return(domain.Film{Title: "A New Hope"}, nil).Once()
+	resolver := NewRelationResolver(mockRepo, mockRepo, mockRepo, 10, 2)
+
+	planets := []domain.Planet{
+		{Name: "Tatooine", Resident: []string{personURL1, "not-a-swapi-url"}, Films: []string{filmURL1}},
+	}
+
+	err := resolver.ExpandPlanets(context.Background(), planets, []string{RelationResidents, RelationFilms})
+
+	require.NoError(t, err)
+	require.NotNil(t, planets[0].Expanded)
+	assert.Equal(t, []domain.PersonSummary{{URL: personURL1, Name: "Luke Skywalker"}}, planets[0].Expanded.Residents)
+	assert.Equal(t, "A New Hope", planets[0].Expanded.Films[0].Title)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRelationResolver_NoRelations(t *testing.T) {
+	mockRepo := mocks.NewMockSwapiRepository()
+	resolver := NewRelationResolver(mockRepo, mockRepo, mockRepo, 10, 2)
+
+	people := []domain.Person{{Name: "Luke Skywalker", Films: []string{filmURL1}}}
+	err := resolver.ExpandPeople(context.Background(), people, nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, people[0].Expanded)
+	mockRepo.AssertNotCalled(t, "FetchFilmByID", mock.Anything, mock.Anything)
+}
+
+// slowFilmRepo records how many film fetches run at the same time.
+type slowFilmRepo struct {
+	mocks.MockSwapiRepository
+	inFlight atomic.Int32
+	peak     atomic.Int32
+	fail     bool
+	mu       sync.Mutex
+	calls    int
+}
+
+func (r *slowFilmRepo) FetchFilmByID(ctx context.Context, id string) (domain.Film, error) {
+	r.mu.Lock()
+	r.calls++
+	r.mu.Unlock()
+
+	current := r.inFlight.Add(1)
+	defer r.inFlight.Add(-1)
+	for {
+		peak := r.peak.Load()
+		if current <= peak || r.peak.CompareAndSwap(peak, current) {
+			break
+		}
+	}
+
+	if r.fail {
+		return domain.Film{}, errors.New("upstream down")
+	}
+
+	select {
+	case <-time.After(10 * time.Millisecond):
+		return domain.Film{Title: "Film " + id}, nil
+	case <-ctx.Done():
+		return domain.Film{}, ctx.Err()
+	}
+}
+
+func TestRelationResolver_BoundedConcurrency(t *testing.T) {
+	repo := &slowFilmRepo{}
+	resolver := NewRelationResolver(repo, repo, repo, 20, 3)
+
+	people := []domain.Person{{Name: "Many Films"}}
+	for i := 1; i <= 9; i++ {
+		people[0].Films = append(people[0].Films, "https://swapi.dev/api/films/"+strconv.Itoa(i)+"/")
+	}
+
+	err := resolver.ExpandPeople(context.Background(), people, []string{RelationFilms})
+
+	require.NoError(t, err)
+	assert.Len(t, people[0].Expanded.Films, 9)
+	assert.Equal(t, "Film 1", people[0].Expanded.Films[0].Title) // Order preserved
+	assert.LessOrEqual(t, repo.peak.Load(), int32(3))
+}
+
+func TestRelationResolver_CancelsOnError(t *testing.T) {
+	repo := &slowFilmRepo{fail: true}
+	resolver := NewRelationResolver(repo, repo, repo, 20, 1)
+
+	people := []domain.Person{{Name: "Many Films"}}
+	for i := 1; i <= 5; i++ {
+		people[0].Films = append(people[0].Films, "https://swapi.dev/api/films/"+strconv.Itoa(i)+"/")
+	}
+
+	err := resolver.ExpandPeople(context.Background(), people, []string{RelationFilms})
+
+	require.Error(t, err)
+	assert.Nil(t, people[0].Expanded)
+	assert.Equal(t, 1, repo.calls) // Remaining fetches were cancelled
+}
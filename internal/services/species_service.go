@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/pagination"
+	"github.com/stressedbypull/swapi-connector/internal/ports"
+	"github.com/stressedbypull/swapi-connector/internal/search"
+	"github.com/stressedbypull/swapi-connector/internal/sorting"
+)
+
+// SpeciesService handles business logic for species operations.
+type SpeciesService struct {
+	repo       ports.SpeciesRepository
+	collection *CollectionCache[domain.Species] // Complete species collection, filtered before paginating
+}
+
+// NewSpeciesService creates a new species service with dependency injection.
+// collection is the shared cache of the complete species collection.
+func NewSpeciesService(r ports.SpeciesRepository, collection *CollectionCache[domain.Species]) *SpeciesService {
+	return &SpeciesService{
+		repo:       r,
+		collection: collection,
+	}
+}
+
+// ListSpecies fetches a paginated list of species with search, attribute filters and sorting.
+// Filters run over the complete collection before it is paginated, so count and paging
+// describe the matching species rather than a single upstream page.
+func (s *SpeciesService) ListSpecies(ctx context.Context, page, pageSize int, searchTerm string, sort []domain.SortKey, filter domain.SpeciesFilter) (domain.PaginatedResponse[domain.Species], error) {
+	species, err := s.collection.Get(ctx)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Species]{}, err
+	}
+
+	// Apply search filter
+	filtered, err := search.FilterSpeciesByName(species, searchTerm)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Species]{}, err
+	}
+
+	// Apply exact-match attribute filters
+	filtered, err = search.FilterSpeciesByAttributes(filtered, filter)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Species]{}, err
+	}
+
+	// Apply sorting if requested
+	sorting.NewChain(sort, sorting.NewSpeciesSorter).Sort(filtered)
+
+	return pagination.Paginate(filtered, page, pageSize), nil
+}
+
+// GetSpeciesByID fetches a single species by ID.
+func (s *SpeciesService) GetSpeciesByID(ctx context.Context, id string) (domain.Species, error) {
+	return s.repo.FetchSpeciesByID(ctx, id)
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/ports"
+	"github.com/stressedbypull/swapi-connector/internal/search"
+	"github.com/stressedbypull/swapi-connector/internal/sorting"
+)
+
+// StarshipService handles business logic for starship operations.
+type StarshipService struct {
+	repo ports.StarshipsRepository
+}
+
+// NewStarshipService creates a new starship service with dependency injection.
+func NewStarshipService(r ports.StarshipsRepository) *StarshipService {
+	return &StarshipService{repo: r}
+}
+
+// ListStarships fetches a paginated list of starships with search and sorting.
+func (s *StarshipService) ListStarships(ctx context.Context, page, pageSize int, searchTerm string, sort []domain.SortKey) (domain.PaginatedResponse[domain.Starship], error) {
+	// Fetch from repository
+	result, err := s.repo.FetchStarships(ctx, page, pageSize, searchTerm)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Starship]{}, err
+	}
+
+	// Apply search filter
+	filtered, err := search.FilterStarshipsByName(result.Results, searchTerm)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Starship]{}, err
+	}
+	result.Results = filtered
+
+	// Apply sorting if requested
+	sorting.NewChain(sort, sorting.NewStarshipSorter).Sort(result.Results)
+
+	return result, nil
+}
+
+// GetStarshipByID fetches a single starship by ID.
+func (s *StarshipService) GetStarshipByID(ctx context.Context, id string) (domain.Starship, error) {
+	return s.repo.FetchStarshipByID(ctx, id)
+}
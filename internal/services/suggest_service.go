@@ -0,0 +1,152 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/search"
+)
+
+// Resource types returned by suggestions (and accepted by ?type=), named like their routes.
+const (
+	SuggestPeople  = "people"
+	SuggestPlanets = "planets"
+	SuggestFilms   = "films"
+	SuggestSpecies = "species"
+)
+
+// SuggestService completes names from an in-memory prefix index over the full
+// people, planets, films and species collections. The index is built on first use
+// and rebuilt every refresh interval by Run; lookups never call SWAPI.
+// Collections are read through the shared collection caches, so the index does not
+// crawl SWAPI again for data the list endpoints have already loaded.
+type SuggestService struct {
+	people          *CollectionCache[domain.Person]
+	planets         *CollectionCache[domain.Planet]
+	films           *CollectionCache[domain.Film]
+	species         *CollectionCache[domain.Species]
+	refreshInterval time.Duration
+
+	index     atomic.Pointer[search.PrefixIndex] // Nil until the first successful build
+	refreshMu sync.Mutex                         // Serializes rebuilds
+}
+
+// NewSuggestService creates a suggestion service with dependency injection.
+func NewSuggestService(people *CollectionCache[domain.Person], planets *CollectionCache[domain.Planet], films *CollectionCache[domain.Film], species *CollectionCache[domain.Species], refreshInterval time.Duration) *SuggestService {
+	return &SuggestService{
+		people:          people,
+		planets:         planets,
+		films:           films,
+		species:         species,
+		refreshInterval: refreshInterval,
+	}
+}
+
+// Suggest returns up to limit ranked name completions for q, restricted to types
+// (all types when empty). The index is built on the first call if Run has not built it yet.
+func (s *SuggestService) Suggest(ctx context.Context, q string, types []string, limit int) ([]domain.Suggestion, error) {
+	index := s.index.Load()
+	if index == nil {
+		var err error
+		if index, err = s.buildOnce(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return index.Lookup(q, types, limit), nil
+}
+
+// Run builds the index, then rebuilds it every refresh interval until ctx is cancelled.
+// A failed rebuild is logged and the previous index keeps serving.
+func (s *SuggestService) Run(ctx context.Context) {
+	if err := s.Refresh(ctx); err != nil {
+		log.Printf("suggest: building index failed: %v", err)
+	}
+	if s.refreshInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Refresh(ctx); err != nil {
+				log.Printf("suggest: refreshing index failed: %v", err)
+			}
+		}
+	}
+}
+
+// Refresh rebuilds the index from the collection caches and swaps it in atomically.
+// Only collections whose cache has expired are fetched from SWAPI again.
+func (s *SuggestService) Refresh(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	_, err := s.build(ctx)
+	return err
+}
+
+// buildOnce builds the index unless a concurrent caller already did,
+// so a burst of first requests triggers a single upstream load.
+func (s *SuggestService) buildOnce(ctx context.Context) (*search.PrefixIndex, error) {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	if index := s.index.Load(); index != nil {
+		return index, nil
+	}
+	return s.build(ctx)
+}
+
+// build loads every collection and stores the new index. Callers hold refreshMu.
+func (s *SuggestService) build(ctx context.Context) (*search.PrefixIndex, error) {
+	var suggestions []domain.Suggestion
+
+	people, err := s.people.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading people: %w", err)
+	}
+	suggestions = appendSuggestions(suggestions, SuggestPeople, people, idOfPerson)
+
+	planets, err := s.planets.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading planets: %w", err)
+	}
+	suggestions = appendSuggestions(suggestions, SuggestPlanets, planets, idOfPlanet)
+
+	films, err := s.films.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading films: %w", err)
+	}
+	suggestions = appendSuggestions(suggestions, SuggestFilms, films, idOfFilm)
+
+	species, err := s.species.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading species: %w", err)
+	}
+	suggestions = appendSuggestions(suggestions, SuggestSpecies, species, idOfSpecies)
+
+	index := search.NewPrefixIndex(suggestions)
+	s.index.Store(index)
+	return index, nil
+}
+
+func idOfPerson(p domain.Person) string { return p.ID }
+
+// appendSuggestions adds one suggestion per item of the given type.
+func appendSuggestions[T interface{ GetName() string }](suggestions []domain.Suggestion, typ string, items []T, id func(T) string) []domain.Suggestion {
+	for _, item := range items {
+		suggestions = append(suggestions, domain.Suggestion{Type: typ, ID: id(item), Name: item.GetName()})
+	}
+	return suggestions
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestService_Suggest(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockSwapiRepository()
+	mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{
+		{ID: "1", Name: "Luke Skywalker"},
+		{ID: "81", Name: "Lumiya"},
+	}, nil).Once()
+	mockRepo.On("FetchAllPlanets", mock.Anything).Return([]domain.Planet{{ID: "61", Name: "Lutrillia"}}, nil).Once()
+	mockRepo.On("FetchAllFilms", mock.Anything).Return([]domain.Film{{ID: "1", Title: "A New Hope"}}, nil).Once()
+	mockRepo.On("FetchAllSpecies", mock.Anything).Return([]domain.Species{{ID: "1", Name: "Human"}}, nil).Once()
+	service := newSuggestService(mockRepo, time.Minute)
+
+	result, err := service.Suggest(ctx, "lu", nil, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Suggestion{
+		{Type: SuggestPeople, ID: "81", Name: "Lumiya"},
+		{Type: SuggestPlanets, ID: "61", Name: "Lutrillia"},
+		{Type: SuggestPeople, ID: "1", Name: "Luke Skywalker"},
+	}, result)
+
+	// The index is reused: the mocks expect a single load
+	result, err = service.Suggest(ctx, "lu", []string{SuggestPlanets}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Suggestion{{Type: SuggestPlanets, ID: "61", Name: "Lutrillia"}}, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSuggestService_Refresh(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockSwapiRepository()
+	mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{{ID: "1", Name: "Luke Skywalker"}}, nil).Once()
+	mockRepo.On("FetchAllPlanets", mock.Anything).Return([]domain.Planet{}, nil)
+	mockRepo.On("FetchAllFilms", mock.Anything).Return([]domain.Film{}, nil)
+	mockRepo.On("FetchAllSpecies", mock.Anything).Return([]domain.Species{}, nil)
+	service := newSuggestService(mockRepo, 0) // Every refresh reloads the collections
+	require.NoError(t, service.Refresh(ctx))
+
+	// A failed rebuild keeps serving the previous index
+	mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person(nil), errors.New("upstream down")).Once()
+	assert.Error(t, service.Refresh(ctx))
+
+	result, err := service.Suggest(ctx, "luke", nil, 10)
+	require.NoError(t, err)
+	assert.Len(t, result, 1)
+
+	// A successful rebuild picks up new names
+	mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{
+		{ID: "1", Name: "Luke Skywalker"},
+		{ID: "81", Name: "Lumiya"},
+	}, nil).Once()
+	require.NoError(t, service.Refresh(ctx))
+
+	result, err = service.Suggest(ctx, "lu", nil, 10)
+	require.NoError(t, err)
+	assert.Len(t, result, 2)
+}
+
+func TestSuggestService_SharesCollectionCaches(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := mocks.NewMockSwapiRepository()
+	mockRepo.On("APIRetrieveAllPeople", mock.Anything).Return([]domain.Person{{ID: "1", Name: "Luke Skywalker", HomeworldID: "1"}}, nil).Once()
+	mockRepo.On("FetchAllPlanets", mock.Anything).Return([]domain.Planet{{ID: "1", Name: "Tatooine"}}, nil).Once()
+	mockRepo.On("FetchAllFilms", mock.Anything).Return([]domain.Film{}, nil).Once()
+	mockRepo.On("FetchAllSpecies", mock.Anything).Return([]domain.Species{}, nil).Once()
+
+	people := NewCollectionCache(time.Minute, mockRepo.APIRetrieveAllPeople)
+	planets := NewCollectionCache(time.Minute, mockRepo.FetchAllPlanets)
+	films := NewCollectionCache(time.Minute, mockRepo.FetchAllFilms)
+	species := NewCollectionCache(time.Minute, mockRepo.FetchAllSpecies)
+	suggest := NewSuggestService(people, planets, films, species, time.Hour)
+	names := NewRelatedNames(planets, films, species)
+	peopleService := NewPeopleService(mockRepo, people, nil, names, ListSettings{PageSize: 15})
+
+	_, err := suggest.Suggest(ctx, "tat", nil, 10)
+	require.NoError(t, err)
+
+	// Related-name searches and whole-collection lists reuse what the index loaded
+	result, err := peopleService.ListPeople(ctx, domain.ListQuery{Page: 1, Search: "tatooine", SearchIn: []string{SearchInHomeworld}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Count)
+
+	// The mocks expect a single load per collection
+	mockRepo.AssertExpectations(t)
+}
+
+// newSuggestService creates a suggestion service reading every collection from repo
+// through caches that keep it for ttl.
+func newSuggestService(repo *mocks.MockSwapiRepository, ttl time.Duration) *SuggestService {
+	return NewSuggestService(
+		NewCollectionCache(ttl, repo.APIRetrieveAllPeople),
+		NewCollectionCache(ttl, repo.FetchAllPlanets),
+		NewCollectionCache(ttl, repo.FetchAllFilms),
+		NewCollectionCache(ttl, repo.FetchAllSpecies),
+		time.Hour,
+	)
+}
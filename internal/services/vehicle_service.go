@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"github.com/stressedbypull/swapi-connector/internal/ports"
+	"github.com/stressedbypull/swapi-connector/internal/search"
+	"github.com/stressedbypull/swapi-connector/internal/sorting"
+)
+
+// VehicleService handles business logic for vehicle operations.
+type VehicleService struct {
+	repo ports.VehiclesRepository
+}
+
+// NewVehicleService creates a new vehicle service with dependency injection.
+func NewVehicleService(r ports.VehiclesRepository) *VehicleService {
+	return &VehicleService{repo: r}
+}
+
+// ListVehicles fetches a paginated list of vehicles with search and sorting.
+func (s *VehicleService) ListVehicles(ctx context.Context, page, pageSize int, searchTerm string, sort []domain.SortKey) (domain.PaginatedResponse[domain.Vehicle], error) {
+	// Fetch from repository
+	result, err := s.repo.FetchVehicles(ctx, page, pageSize, searchTerm)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Vehicle]{}, err
+	}
+
+	// Apply search filter
+	filtered, err := search.FilterVehiclesByName(result.Results, searchTerm)
+	if err != nil {
+		return domain.PaginatedResponse[domain.Vehicle]{}, err
+	}
+	result.Results = filtered
+
+	// Apply sorting if requested
+	sorting.NewChain(sort, sorting.NewVehicleSorter).Sort(result.Results)
+
+	return result, nil
+}
+
+// GetVehicleByID fetches a single vehicle by ID.
+func (s *VehicleService) GetVehicleByID(ctx context.Context, id string) (domain.Vehicle, error) {
+	return s.repo.FetchVehicleByID(ctx, id)
+}
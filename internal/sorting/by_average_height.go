@@ -0,0 +1,21 @@
+package sorting
+
+import "github.com/stressedbypull/swapi-connector/internal/domain"
+
+// ByAverageHeight sorts species by average height (Species-specific sorter).
+type ByAverageHeight struct{}
+
+// Sort sorts species by average height in ascending or descending order, unknown heights last.
+func (s ByAverageHeight) Sort(species []domain.Species, ascending bool) {
+	sortStable(species, s, ascending)
+}
+
+// Compare orders species by average height, shortest first and unknown heights last.
+func (s ByAverageHeight) Compare(a, b domain.Species) int {
+	return compareMeasurements(a.AverageHeight, b.AverageHeight)
+}
+
+// Known reports whether the species' average height is known (implements Nullable).
+func (s ByAverageHeight) Known(species domain.Species) bool {
+	return species.AverageHeight.Known
+}
@@ -0,0 +1,67 @@
+package sorting
+
+import (
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+func TestByAverageHeight_Sort(t *testing.T) {
+	tests := []struct {
+		name       string
+		species    []domain.Species
+		ascending  bool
+		wantHeight []float64
+	}{
+		{
+			name: "sort ascending",
+			species: []domain.Species{
+				{Name: "Wookie", AverageHeight: domain.Measure(210)},
+				{Name: "Yoda's species", AverageHeight: domain.Measure(66)},
+				{Name: "Human", AverageHeight: domain.Measure(180)},
+			},
+			ascending:  true,
+			wantHeight: []float64{66, 180, 210},
+		},
+		{
+			name: "sort descending with unknown height",
+			species: []domain.Species{
+				{Name: "Unknown", AverageHeight: domain.Measurement{}},
+				{Name: "Human", AverageHeight: domain.Measure(180)},
+				{Name: "Wookie", AverageHeight: domain.Measure(210)},
+			},
+			ascending:  false,
+			wantHeight: []float64{210, 180, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorter := ByAverageHeight{}
+			sorter.Sort(tt.species, tt.ascending)
+
+			for i, s := range tt.species {
+				if s.AverageHeight.Value != tt.wantHeight[i] {
+					t.Errorf("position %d: got %v, want %v", i, s.AverageHeight.Value, tt.wantHeight[i])
+				}
+			}
+		})
+	}
+}
+
+func TestByAverageLifespan_Sort(t *testing.T) {
+	species := []domain.Species{
+		{Name: "Human", AverageLifespan: domain.Measure(120)},
+		{Name: "Wookie", AverageLifespan: domain.Measure(400)},
+		{Name: "Hutt", AverageLifespan: domain.Measure(1000)},
+	}
+
+	ByAverageLifespan{}.Sort(species, false)
+
+	want := []float64{1000, 400, 120}
+	for i, s := range species {
+		if s.AverageLifespan.Value != want[i] {
+			t.Errorf("position %d: got %v, want %v", i, s.AverageLifespan.Value, want[i])
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package sorting
+
+import "github.com/stressedbypull/swapi-connector/internal/domain"
+
+// ByAverageLifespan sorts species by average lifespan (Species-specific sorter).
+type ByAverageLifespan struct{}
+
+// Sort sorts species by average lifespan in ascending or descending order.
+// Unknown and "indefinite" lifespans sort last.
+func (s ByAverageLifespan) Sort(species []domain.Species, ascending bool) {
+	sortStable(species, s, ascending)
+}
+
+// Compare orders species by average lifespan, shortest first and unknown lifespans last.
+func (s ByAverageLifespan) Compare(a, b domain.Species) int {
+	return compareMeasurements(a.AverageLifespan, b.AverageLifespan)
+}
+
+// Known reports whether the species' average lifespan is known (implements Nullable).
+func (s ByAverageLifespan) Known(species domain.Species) bool {
+	return species.AverageLifespan.Known
+}
@@ -0,0 +1,19 @@
+package sorting
+
+// ByCost sorts any Craft entities by cost in credits.
+type ByCost[T Craft] struct{}
+
+// Sort sorts entities by cost in ascending or descending order, unknown costs last.
+func (s ByCost[T]) Sort(items []T, ascending bool) {
+	sortStable(items, s, ascending)
+}
+
+// Compare orders entities by cost, cheapest first and unknown costs last.
+func (s ByCost[T]) Compare(a, b T) int {
+	return compareMeasurements(a.GetCostInCredits(), b.GetCostInCredits())
+}
+
+// Known reports whether the entity's cost is known (implements Nullable).
+func (s ByCost[T]) Known(item T) bool {
+	return item.GetCostInCredits().Known
+}
@@ -0,0 +1,84 @@
+package sorting
+
+import (
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+func TestByCost_Sort(t *testing.T) {
+	tests := []struct {
+		name      string
+		starships []domain.Starship
+		ascending bool
+		wantCost  []float64
+	}{
+		{
+			name: "sort ascending",
+			starships: []domain.Starship{
+				{Name: "Death Star", CostInCredits: domain.Measure(1000000000000)},
+				{Name: "Millennium Falcon", CostInCredits: domain.Measure(100000)},
+				{Name: "X-wing", CostInCredits: domain.Measure(149999)},
+			},
+			ascending: true,
+			wantCost:  []float64{100000, 149999, 1000000000000},
+		},
+		{
+			name: "unknown cost sorts last when descending",
+			starships: []domain.Starship{
+				{Name: "Unknown", CostInCredits: domain.Measurement{}},
+				{Name: "Millennium Falcon", CostInCredits: domain.Measure(100000)},
+				{Name: "X-wing", CostInCredits: domain.Measure(149999)},
+			},
+			ascending: false,
+			wantCost:  []float64{149999, 100000, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorter := ByCost[domain.Starship]{}
+			sorter.Sort(tt.starships, tt.ascending)
+
+			for i, starship := range tt.starships {
+				if starship.CostInCredits.Value != tt.wantCost[i] {
+					t.Errorf("position %d: got %v, want %v", i, starship.CostInCredits.Value, tt.wantCost[i])
+				}
+			}
+		})
+	}
+}
+
+func TestByLength_Sort(t *testing.T) {
+	vehicles := []domain.Vehicle{
+		{Name: "Sand Crawler", Length: domain.Measure(36.8)},
+		{Name: "Snowspeeder", Length: domain.Measure(4.5)},
+		{Name: "AT-AT", Length: domain.Measure(20)},
+	}
+
+	ByLength[domain.Vehicle]{}.Sort(vehicles, true)
+
+	want := []float64{4.5, 20, 36.8}
+	for i, vehicle := range vehicles {
+		if vehicle.Length.Value != want[i] {
+			t.Errorf("position %d: got %v, want %v", i, vehicle.Length.Value, want[i])
+		}
+	}
+}
+
+func TestByMaxSpeed_Sort(t *testing.T) {
+	vehicles := []domain.Vehicle{
+		{Name: "Sand Crawler", MaxAtmospheringSpeed: domain.Measure(30)},
+		{Name: "Snowspeeder", MaxAtmospheringSpeed: domain.Measure(650)},
+		{Name: "AT-AT", MaxAtmospheringSpeed: domain.Measure(60)},
+	}
+
+	ByMaxSpeed[domain.Vehicle]{}.Sort(vehicles, false)
+
+	want := []float64{650, 60, 30}
+	for i, vehicle := range vehicles {
+		if vehicle.MaxAtmospheringSpeed.Value != want[i] {
+			t.Errorf("position %d: got %v, want %v", i, vehicle.MaxAtmospheringSpeed.Value, want[i])
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package sorting
+
+import "github.com/stressedbypull/swapi-connector/internal/domain"
+
+// ByDiameter sorts planets by diameter (Planet-specific sorter).
+type ByDiameter struct{}
+
+// Sort sorts planets by diameter in ascending or descending order, unknown diameters last.
+func (s ByDiameter) Sort(planets []domain.Planet, ascending bool) {
+	sortStable(planets, s, ascending)
+}
+
+// Compare orders planets by diameter, smallest first and unknown diameters last.
+func (s ByDiameter) Compare(a, b domain.Planet) int {
+	return compareMeasurements(a.Diameter, b.Diameter)
+}
+
+// Known reports whether the planet's diameter is known (implements Nullable).
+func (s ByDiameter) Known(p domain.Planet) bool {
+	return p.Diameter.Known
+}
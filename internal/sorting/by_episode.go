@@ -0,0 +1,20 @@
+package sorting
+
+import (
+	"cmp"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+// ByEpisode sorts films by episode number (Film-specific sorter).
+type ByEpisode struct{}
+
+// Sort sorts films by episode ID in ascending or descending order.
+func (s ByEpisode) Sort(films []domain.Film, ascending bool) {
+	sortStable(films, s, ascending)
+}
+
+// Compare orders films by episode ID, lowest first.
+func (s ByEpisode) Compare(a, b domain.Film) int {
+	return cmp.Compare(a.EpisodeID, b.EpisodeID)
+}
@@ -0,0 +1,50 @@
+package sorting
+
+import (
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+func TestByEpisode_Sort(t *testing.T) {
+	tests := []struct {
+		name         string
+		films        []domain.Film
+		ascending    bool
+		wantEpisodes []int
+	}{
+		{
+			name: "sort ascending",
+			films: []domain.Film{
+				{Title: "The Empire Strikes Back", EpisodeID: 5},
+				{Title: "The Phantom Menace", EpisodeID: 1},
+				{Title: "A New Hope", EpisodeID: 4},
+			},
+			ascending:    true,
+			wantEpisodes: []int{1, 4, 5},
+		},
+		{
+			name: "sort descending",
+			films: []domain.Film{
+				{Title: "A New Hope", EpisodeID: 4},
+				{Title: "The Phantom Menace", EpisodeID: 1},
+				{Title: "The Empire Strikes Back", EpisodeID: 5},
+			},
+			ascending:    false,
+			wantEpisodes: []int{5, 4, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorter := ByEpisode{}
+			sorter.Sort(tt.films, tt.ascending)
+
+			for i, film := range tt.films {
+				if film.EpisodeID != tt.wantEpisodes[i] {
+					t.Errorf("position %d: got %d, want %d", i, film.EpisodeID, tt.wantEpisodes[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package sorting
+
+import "github.com/stressedbypull/swapi-connector/internal/domain"
+
+// ByHyperdrive sorts starships by hyperdrive rating (Starship-specific sorter).
+type ByHyperdrive struct{}
+
+// Sort sorts starships by hyperdrive rating in ascending or descending order, unknown ratings last.
+// Note: a lower rating means a faster hyperdrive.
+func (s ByHyperdrive) Sort(starships []domain.Starship, ascending bool) {
+	sortStable(starships, s, ascending)
+}
+
+// Compare orders starships by hyperdrive rating, fastest (lowest) first and unknown ratings last.
+func (s ByHyperdrive) Compare(a, b domain.Starship) int {
+	return compareMeasurements(a.HyperdriveRating, b.HyperdriveRating)
+}
+
+// Known reports whether the starship's hyperdrive rating is known (implements Nullable).
+func (s ByHyperdrive) Known(starship domain.Starship) bool {
+	return starship.HyperdriveRating.Known
+}
@@ -0,0 +1,50 @@
+package sorting
+
+import (
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+func TestByHyperdrive_Sort(t *testing.T) {
+	tests := []struct {
+		name       string
+		starships  []domain.Starship
+		ascending  bool
+		wantRating []float64
+	}{
+		{
+			name: "sort ascending (fastest first)",
+			starships: []domain.Starship{
+				{Name: "Star Destroyer", HyperdriveRating: domain.Measure(2.0)},
+				{Name: "Millennium Falcon", HyperdriveRating: domain.Measure(0.5)},
+				{Name: "X-wing", HyperdriveRating: domain.Measure(1.0)},
+			},
+			ascending:  true,
+			wantRating: []float64{0.5, 1.0, 2.0},
+		},
+		{
+			name: "sort descending",
+			starships: []domain.Starship{
+				{Name: "Millennium Falcon", HyperdriveRating: domain.Measure(0.5)},
+				{Name: "Star Destroyer", HyperdriveRating: domain.Measure(2.0)},
+				{Name: "X-wing", HyperdriveRating: domain.Measure(1.0)},
+			},
+			ascending:  false,
+			wantRating: []float64{2.0, 1.0, 0.5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorter := ByHyperdrive{}
+			sorter.Sort(tt.starships, tt.ascending)
+
+			for i, starship := range tt.starships {
+				if starship.HyperdriveRating.Value != tt.wantRating[i] {
+					t.Errorf("position %d: got %v, want %v", i, starship.HyperdriveRating.Value, tt.wantRating[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package sorting
+
+// ByLength sorts any Craft entities by length.
+type ByLength[T Craft] struct{}
+
+// Sort sorts entities by length in ascending or descending order, unknown lengths last.
+func (s ByLength[T]) Sort(items []T, ascending bool) {
+	sortStable(items, s, ascending)
+}
+
+// Compare orders entities by length, shortest first and unknown lengths last.
+func (s ByLength[T]) Compare(a, b T) int {
+	return compareMeasurements(a.GetLength(), b.GetLength())
+}
+
+// Known reports whether the entity's length is known (implements Nullable).
+func (s ByLength[T]) Known(item T) bool {
+	return item.GetLength().Known
+}
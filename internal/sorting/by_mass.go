@@ -1,21 +1,22 @@
 package sorting
 
-import (
-	"sort"
-
-	"github.com/stressedbypull/swapi-connector/internal/domain"
-)
+import "github.com/stressedbypull/swapi-connector/internal/domain"
 
 // ByMass sorts people by mass field (Person-specific sorter).
 type ByMass struct{}
 
-// Sort sorts people by mass in ascending or descending order.
+// Sort sorts people by mass in ascending or descending order, unknown masses last.
 // Note: This is Person-specific and doesn't use generics since mass is unique to Person.
 func (s ByMass) Sort(people []domain.Person, ascending bool) {
-	sort.Slice(people, func(i, j int) bool {
-		if ascending {
-			return people[i].Mass < people[j].Mass
-		}
-		return people[i].Mass > people[j].Mass
-	})
+	sortStable(people, s, ascending)
+}
+
+// Compare orders people by mass, lightest first and unknown masses last.
+func (s ByMass) Compare(a, b domain.Person) int {
+	return compareMeasurements(a.Mass, b.Mass)
+}
+
+// Known reports whether the person's mass is known (implements Nullable).
+func (s ByMass) Known(p domain.Person) bool {
+	return p.Mass.Known
 }
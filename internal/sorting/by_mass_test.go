@@ -11,37 +11,37 @@ func TestByMass_Sort(t *testing.T) {
 		name      string
 		people    []domain.Person
 		ascending bool
-		wantMass  []int
+		wantMass  []float64
 	}{
 		{
 			name: "sort ascending",
 			people: []domain.Person{
-				{Name: "Person2", Mass: 80},
-				{Name: "Person3", Mass: 136},
-				{Name: "Person1", Mass: 77},
+				{Name: "Person2", Mass: domain.Measure(80)},
+				{Name: "Person3", Mass: domain.Measure(136)},
+				{Name: "Person1", Mass: domain.Measure(77)},
 			},
 			ascending: true,
-			wantMass:  []int{77, 80, 136},
+			wantMass:  []float64{77, 80, 136},
 		},
 		{
 			name: "sort descending",
 			people: []domain.Person{
-				{Name: "Person2", Mass: 80},
-				{Name: "Person1", Mass: 77},
-				{Name: "Person3", Mass: 136},
+				{Name: "Person2", Mass: domain.Measure(80)},
+				{Name: "Person1", Mass: domain.Measure(77)},
+				{Name: "Person3", Mass: domain.Measure(136)},
 			},
 			ascending: false,
-			wantMass:  []int{136, 80, 77},
+			wantMass:  []float64{136, 80, 77},
 		},
 		{
-			name: "with zero mass",
+			name: "unknown mass sorts last",
 			people: []domain.Person{
-				{Name: "Person2", Mass: 80},
-				{Name: "Person3", Mass: 0},
-				{Name: "Person1", Mass: 77},
+				{Name: "Person2", Mass: domain.Measure(80)},
+				{Name: "Person3", Mass: domain.Measurement{}},
+				{Name: "Person1", Mass: domain.Measure(77)},
 			},
 			ascending: true,
-			wantMass:  []int{0, 77, 80},
+			wantMass:  []float64{77, 80, 0},
 		},
 	}
 
@@ -51,8 +51,8 @@ func TestByMass_Sort(t *testing.T) {
 			sorter.Sort(tt.people, tt.ascending)
 
 			for i, person := range tt.people {
-				if person.Mass != tt.wantMass[i] {
-					t.Errorf("position %d: got %d, want %d", i, person.Mass, tt.wantMass[i])
+				if person.Mass.Value != tt.wantMass[i] {
+					t.Errorf("position %d: got %v, want %v", i, person.Mass.Value, tt.wantMass[i])
 				}
 			}
 		})
@@ -0,0 +1,20 @@
+package sorting
+
+// ByMaxSpeed sorts any Craft entities by maximum atmosphering speed.
+type ByMaxSpeed[T Craft] struct{}
+
+// Sort sorts entities by maximum atmosphering speed in ascending or descending order,
+// unknown speeds (e.g. "n/a" for spacecraft) last.
+func (s ByMaxSpeed[T]) Sort(items []T, ascending bool) {
+	sortStable(items, s, ascending)
+}
+
+// Compare orders entities by maximum atmosphering speed, slowest first and unknown speeds last.
+func (s ByMaxSpeed[T]) Compare(a, b T) int {
+	return compareMeasurements(a.GetMaxAtmospheringSpeed(), b.GetMaxAtmospheringSpeed())
+}
+
+// Known reports whether the entity's maximum atmosphering speed is known (implements Nullable).
+func (s ByMaxSpeed[T]) Known(item T) bool {
+	return item.GetMaxAtmospheringSpeed().Known
+}
@@ -1,23 +1,30 @@
 package sorting
 
-import (
-	"sort"
-	"strings"
-)
+import "golang.org/x/text/language"
 
-// ByName sorts any Sortable entities by name field.
-type ByName[T Sortable] struct{}
+// ByName sorts any Sortable entities by name field using locale-aware collation.
+// The zero value collates for DefaultLocale.
+type ByName[T Sortable] struct {
+	Locale language.Tag // Collation locale; language.Und uses DefaultLocale
+}
 
 // Sort sorts entities by name in ascending or descending order.
 func (s ByName[T]) Sort(items []T, ascending bool) {
-	sort.Slice(items, func(i, j int) bool {
-		cmp := strings.Compare(
-			strings.ToLower(items[i].GetName()),
-			strings.ToLower(items[j].GetName()),
-		)
-		if ascending {
-			return cmp < 0
-		}
-		return cmp > 0
-	})
+	sortStable(items, s, ascending)
+}
+
+// Compare orders entities by name, A to Z in the sorter's locale. Case is ignored,
+// accents are compared after base letters and embedded numbers by value, so
+// "Padmé Amidala" sorts among the P's and "R2-D2" before "R5-D4".
+func (s ByName[T]) Compare(a, b T) int {
+	locale := s.Locale
+	if locale == language.Und {
+		locale = DefaultLocale
+	}
+	return collateStrings(locale, a.GetName(), b.GetName())
+}
+
+// WithLocale returns a ByName sorter collating for locale (implements Collated).
+func (s ByName[T]) WithLocale(locale language.Tag) Sorter[T] {
+	return ByName[T]{Locale: locale}
 }
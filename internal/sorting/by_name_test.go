@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"golang.org/x/text/language"
 )
 
 func TestByName_Sort(t *testing.T) {
@@ -58,3 +59,51 @@ func TestByName_Sort(t *testing.T) {
 		})
 	}
 }
+
+func TestByName_Collation(t *testing.T) {
+	tests := []struct {
+		name   string
+		sorter ByName[domain.Person]
+		people []string
+		want   []string
+	}{
+		{
+			name:   "case and accents do not push names out of place",
+			people: []string{"Padmé Amidala", "bib Fortuna", "Bossk", "Owen Lars", "Poggle the Lesser"},
+			want:   []string{"bib Fortuna", "Bossk", "Owen Lars", "Padmé Amidala", "Poggle the Lesser"},
+		},
+		{
+			name:   "embedded numbers sort by value",
+			people: []string{"R5-D4", "IG-88", "R2-D2", "IG-100", "C-3PO"},
+			want:   []string{"C-3PO", "IG-88", "IG-100", "R2-D2", "R5-D4"},
+		},
+		{
+			name:   "locale decides where accented letters go",
+			sorter: ByName[domain.Person]{Locale: language.Swedish},
+			people: []string{"Zam Wesell", "Ödön", "Obi-Wan Kenobi"},
+			want:   []string{"Obi-Wan Kenobi", "Zam Wesell", "Ödön"},
+		},
+		{
+			name:   "default locale keeps accented letters with their base letter",
+			people: []string{"Zam Wesell", "Ödön", "Obi-Wan Kenobi"},
+			want:   []string{"Obi-Wan Kenobi", "Ödön", "Zam Wesell"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			people := make([]domain.Person, len(tt.people))
+			for i, name := range tt.people {
+				people[i] = domain.Person{Name: name}
+			}
+
+			tt.sorter.Sort(people, true)
+
+			for i, person := range people {
+				if person.Name != tt.want[i] {
+					t.Errorf("position %d: got %s, want %s", i, person.Name, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package sorting
+
+import "github.com/stressedbypull/swapi-connector/internal/domain"
+
+// ByPopulation sorts planets by population (Planet-specific sorter).
+type ByPopulation struct{}
+
+// Sort sorts planets by population in ascending or descending order, unknown populations last.
+func (s ByPopulation) Sort(planets []domain.Planet, ascending bool) {
+	sortStable(planets, s, ascending)
+}
+
+// Compare orders planets by population, smallest first and unknown populations last.
+func (s ByPopulation) Compare(a, b domain.Planet) int {
+	return compareMeasurements(a.Population, b.Population)
+}
+
+// Known reports whether the planet's population is known (implements Nullable).
+func (s ByPopulation) Known(p domain.Planet) bool {
+	return p.Population.Known
+}
@@ -0,0 +1,66 @@
+package sorting
+
+import (
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+func TestByPopulation_Sort(t *testing.T) {
+	tests := []struct {
+		name      string
+		planets   []domain.Planet
+		ascending bool
+		wantNames []string
+	}{
+		{
+			name: "sort ascending",
+			planets: []domain.Planet{
+				{Name: "Coruscant", Population: domain.Measure(1000000000000)},
+				{Name: "Tatooine", Population: domain.Measure(200000)},
+				{Name: "Alderaan", Population: domain.Measure(2000000000)},
+			},
+			ascending: true,
+			wantNames: []string{"Tatooine", "Alderaan", "Coruscant"},
+		},
+		{
+			name: "sort descending",
+			planets: []domain.Planet{
+				{Name: "Tatooine", Population: domain.Measure(200000)},
+				{Name: "Coruscant", Population: domain.Measure(1000000000000)},
+				{Name: "Alderaan", Population: domain.Measure(2000000000)},
+			},
+			ascending: false,
+			wantNames: []string{"Coruscant", "Alderaan", "Tatooine"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ByPopulation{}.Sort(tt.planets, tt.ascending)
+
+			for i, planet := range tt.planets {
+				if planet.Name != tt.wantNames[i] {
+					t.Errorf("position %d: got %s, want %s", i, planet.Name, tt.wantNames[i])
+				}
+			}
+		})
+	}
+}
+
+func TestByDiameter_Sort(t *testing.T) {
+	planets := []domain.Planet{
+		{Name: "Alderaan", Diameter: domain.Measure(12500)},
+		{Name: "Yavin IV", Diameter: domain.Measure(10200)},
+		{Name: "Tatooine", Diameter: domain.Measure(10465)},
+	}
+
+	ByDiameter{}.Sort(planets, true)
+
+	want := []string{"Yavin IV", "Tatooine", "Alderaan"}
+	for i, planet := range planets {
+		if planet.Name != want[i] {
+			t.Errorf("position %d: got %s, want %s", i, planet.Name, want[i])
+		}
+	}
+}
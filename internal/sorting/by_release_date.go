@@ -0,0 +1,16 @@
+package sorting
+
+import "github.com/stressedbypull/swapi-connector/internal/domain"
+
+// ByReleaseDate sorts films by theatrical release date (Film-specific sorter).
+type ByReleaseDate struct{}
+
+// Sort sorts films by release date in ascending or descending order.
+func (s ByReleaseDate) Sort(films []domain.Film, ascending bool) {
+	sortStable(films, s, ascending)
+}
+
+// Compare orders films by release date, earliest first.
+func (s ByReleaseDate) Compare(a, b domain.Film) int {
+	return a.GetReleaseDate().Compare(b.GetReleaseDate())
+}
@@ -0,0 +1,50 @@
+package sorting
+
+import (
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+func TestByReleaseDate_Sort(t *testing.T) {
+	tests := []struct {
+		name      string
+		films     []domain.Film
+		ascending bool
+		wantDates []string
+	}{
+		{
+			name: "sort ascending",
+			films: []domain.Film{
+				{Title: "The Phantom Menace", ReleaseDate: "1999-05-19"},
+				{Title: "A New Hope", ReleaseDate: "1977-05-25"},
+				{Title: "The Empire Strikes Back", ReleaseDate: "1980-05-17"},
+			},
+			ascending: true,
+			wantDates: []string{"1977-05-25", "1980-05-17", "1999-05-19"},
+		},
+		{
+			name: "sort descending",
+			films: []domain.Film{
+				{Title: "A New Hope", ReleaseDate: "1977-05-25"},
+				{Title: "The Phantom Menace", ReleaseDate: "1999-05-19"},
+				{Title: "The Empire Strikes Back", ReleaseDate: "1980-05-17"},
+			},
+			ascending: false,
+			wantDates: []string{"1999-05-19", "1980-05-17", "1977-05-25"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorter := ByReleaseDate{}
+			sorter.Sort(tt.films, tt.ascending)
+
+			for i, film := range tt.films {
+				if film.ReleaseDate != tt.wantDates[i] {
+					t.Errorf("position %d: got %v, want %v", i, film.ReleaseDate, tt.wantDates[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package sorting
+
+import "cmp"
+
+// ByRelevance sorts search results by their fuzzy-match relevance score.
+type ByRelevance[T Scored] struct{}
+
+// Sort ranks entities by relevance. Ascending means rank order (best match first),
+// so the default sortOrder=asc lists the most relevant results at the top.
+// Equal scores keep their original order.
+func (s ByRelevance[T]) Sort(items []T, ascending bool) {
+	sortStable(items, s, ascending)
+}
+
+// Compare orders search results by relevance, best match first.
+func (s ByRelevance[T]) Compare(a, b T) int {
+	return cmp.Compare(b.GetRelevance(), a.GetRelevance())
+}
@@ -0,0 +1,46 @@
+package sorting
+
+import (
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+func TestByRelevance_Sort(t *testing.T) {
+	tests := []struct {
+		name      string
+		ascending bool
+		wantNames []string
+	}{
+		{
+			name:      "ascending ranks best match first",
+			ascending: true,
+			wantNames: []string{"Luke Skywalker", "Anakin Skywalker", "Shmi Skywalker", "Darth Vader"},
+		},
+		{
+			name:      "descending reverses the ranking",
+			ascending: false,
+			wantNames: []string{"Darth Vader", "Anakin Skywalker", "Shmi Skywalker", "Luke Skywalker"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			people := []domain.Person{
+				{Name: "Anakin Skywalker", Relevance: 0.9},
+				{Name: "Darth Vader", Relevance: 0.7},
+				{Name: "Shmi Skywalker", Relevance: 0.9},
+				{Name: "Luke Skywalker", Relevance: 1},
+			}
+
+			ByRelevance[domain.Person]{}.Sort(people, tt.ascending)
+
+			// Equal scores (Anakin, Shmi) keep their original order in both directions
+			for i, person := range people {
+				if person.Name != tt.wantNames[i] {
+					t.Errorf("position %d: got %s, want %s", i, person.Name, tt.wantNames[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package sorting
+
+import (
+	"slices"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+// Chain composes sorters into a multi-key sort, e.g. mass descending then name ascending.
+// Later keys only break ties left by earlier ones; items equal on every key keep their order.
+// Unknown values of Nullable sorters are placed by each key's nulls option, and
+// Collated sorters collate for each key's locale.
+type Chain[T Sortable] []ChainKey[T]
+
+// ChainKey is one sorter of a chain with its direction and placement of unknown values.
+type ChainKey[T Sortable] struct {
+	Sorter    Sorter[T]
+	Ascending bool
+	Nulls     domain.NullsPlacement // Empty behaves like domain.NullsLast
+}
+
+// NewChain resolves sort keys with newSorter, such as NewPersonSorter.
+// Keys with unsupported fields are skipped; handlers reject them before they get here,
+// as they reject malformed locales.
+func NewChain[T Sortable](keys []domain.SortKey, newSorter func(field string) Sorter[T]) Chain[T] {
+	chain := make(Chain[T], 0, len(keys))
+	for _, key := range keys {
+		sorter := newSorter(key.Field)
+		if sorter == nil {
+			continue
+		}
+		if collated, ok := sorter.(Collated[T]); ok && key.Locale != "" {
+			if locale, err := ParseLocale(key.Locale); err == nil {
+				sorter = collated.WithLocale(locale)
+			}
+		}
+		chain = append(chain, ChainKey[T]{Sorter: sorter, Ascending: !key.Descending, Nulls: key.Nulls})
+	}
+	return chain
+}
+
+// Sort stably sorts items by every key in turn.
+func (c Chain[T]) Sort(items []T) {
+	if len(c) == 0 {
+		return
+	}
+	slices.SortStableFunc(items, c.Compare)
+}
+
+// Compare applies the keys in order and returns the first non-zero result.
+func (c Chain[T]) Compare(a, b T) int {
+	for _, key := range c {
+		if result := compareDirected(key.Sorter, key.Ascending, key.Nulls, a, b); result != 0 {
+			return result
+		}
+	}
+	return 0
+}
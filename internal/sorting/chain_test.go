@@ -0,0 +1,112 @@
+package sorting
+
+import (
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+func TestChain_Sort(t *testing.T) {
+	people := func() []domain.Person {
+		return []domain.Person{
+			{Name: "Biggs Darklighter", Mass: domain.Measure(84)},
+			{Name: "Owen Lars", Mass: domain.Measure(120)},
+			{Name: "Anakin Skywalker", Mass: domain.Measure(84)},
+			{Name: "Boba Fett", Mass: domain.Measure(78)},
+			{Name: "anakin skywalker", Mass: domain.Measure(84)},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		keys      []domain.SortKey
+		wantNames []string
+	}{
+		{
+			name: "mass descending, then name ascending",
+			keys: []domain.SortKey{{Field: "mass", Descending: true}, {Field: "name"}},
+			// Names equal ignoring case keep their original order
+			wantNames: []string{"Owen Lars", "Anakin Skywalker", "anakin skywalker", "Biggs Darklighter", "Boba Fett"},
+		},
+		{
+			name:      "single key keeps ties in original order",
+			keys:      []domain.SortKey{{Field: "mass"}},
+			wantNames: []string{"Boba Fett", "Biggs Darklighter", "Anakin Skywalker", "anakin skywalker", "Owen Lars"},
+		},
+		{
+			name:      "unsupported keys are skipped",
+			keys:      []domain.SortKey{{Field: "unknown"}, {Field: "mass", Descending: true}},
+			wantNames: []string{"Owen Lars", "Biggs Darklighter", "Anakin Skywalker", "anakin skywalker", "Boba Fett"},
+		},
+		{
+			name:      "no keys keeps the original order",
+			wantNames: []string{"Biggs Darklighter", "Owen Lars", "Anakin Skywalker", "Boba Fett", "anakin skywalker"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := people()
+			NewChain(tt.keys, NewPersonSorter).Sort(items)
+
+			for i, want := range tt.wantNames {
+				if items[i].Name != want {
+					t.Errorf("position %d: got %q, want %q", i, items[i].Name, want)
+				}
+			}
+		})
+	}
+}
+
+func TestChain_SortNulls(t *testing.T) {
+	people := func() []domain.Person {
+		return []domain.Person{
+			{Name: "Arvel Crynyd"}, // Unknown mass
+			{Name: "Luke Skywalker", Mass: domain.Measure(77)},
+			{Name: "Finis Valorum"}, // Unknown mass
+			{Name: "Jabba Desilijic Tiure", Mass: domain.Measure(1358)},
+			{Name: "Ric Olié"}, // Unknown mass
+			{Name: "Owen Lars", Mass: domain.Measure(120)},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		keys      []domain.SortKey
+		wantNames []string
+	}{
+		{
+			name:      "ascending places unknowns last by default",
+			keys:      []domain.SortKey{{Field: "mass"}},
+			wantNames: []string{"Luke Skywalker", "Owen Lars", "Jabba Desilijic Tiure", "Arvel Crynyd", "Finis Valorum", "Ric Olié"},
+		},
+		{
+			name:      "descending also places unknowns last by default",
+			keys:      []domain.SortKey{{Field: "mass", Descending: true}},
+			wantNames: []string{"Jabba Desilijic Tiure", "Owen Lars", "Luke Skywalker", "Arvel Crynyd", "Finis Valorum", "Ric Olié"},
+		},
+		{
+			name:      "nulls first",
+			keys:      []domain.SortKey{{Field: "mass", Descending: true, Nulls: domain.NullsFirst}},
+			wantNames: []string{"Arvel Crynyd", "Finis Valorum", "Ric Olié", "Jabba Desilijic Tiure", "Owen Lars", "Luke Skywalker"},
+		},
+		{
+			name:      "unknowns are ordered by the next key",
+			keys:      []domain.SortKey{{Field: "mass", Nulls: domain.NullsFirst}, {Field: "name", Descending: true}},
+			wantNames: []string{"Ric Olié", "Finis Valorum", "Arvel Crynyd", "Luke Skywalker", "Owen Lars", "Jabba Desilijic Tiure"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := people()
+			NewChain(tt.keys, NewPersonSorter).Sort(items)
+
+			for i, want := range tt.wantNames {
+				if items[i].Name != want {
+					t.Errorf("position %d: got %q, want %q", i, items[i].Name, want)
+				}
+			}
+		})
+	}
+}
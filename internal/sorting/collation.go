@@ -0,0 +1,107 @@
+package sorting
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// DefaultLocale is the collation locale used when a request does not pick one.
+var DefaultLocale = language.English
+
+// collators holds a pool of collators per locale. A collate.Collator keeps internal
+// buffers and is not safe for concurrent use, so each comparison borrows one.
+var collators sync.Map // language.Tag -> *sync.Pool
+
+// collatorPool returns the pool of collators for locale, creating it on first use.
+// Pools are keyed by the collation key of locale, so their number stays bounded.
+// Collation ignores case and orders embedded numbers by value ("R2-D2" before "R10").
+func collatorPool(locale language.Tag) *sync.Pool {
+	locale = collationKey(locale)
+	if pool, ok := collators.Load(locale); ok {
+		return pool.(*sync.Pool)
+	}
+
+	pool, _ := collators.LoadOrStore(locale, &sync.Pool{
+		New: func() any { return collate.New(locale, collate.IgnoreCase, collate.Numeric) },
+	})
+	return pool.(*sync.Pool)
+}
+
+// collateStrings compares a and b using the collation rules of locale.
+func collateStrings(locale language.Tag, a, b string) int {
+	pool := collatorPool(locale)
+	collator := pool.Get().(*collate.Collator)
+	defer pool.Put(collator)
+
+	return collator.CompareString(a, b)
+}
+
+// supportedLocales matches requested locales against the locales with collation rules.
+var supportedLocales = language.NewMatcher(collate.Supported())
+
+// supportedTags and collationTypes hold the locales with collation rules and their
+// -u-co and -u-va values, e.g. "phonebk" in "de-u-co-phonebk".
+var supportedTags, collationTypes = func() (tags, types map[string]bool) {
+	tags, types = make(map[string]bool), make(map[string]bool)
+	for _, tag := range collate.Supported() {
+		tags[tag.String()] = true
+		for _, key := range []string{"co", "va"} {
+			if value := tag.TypeForKey(key); value != "" {
+				types[key+"-"+value] = true
+			}
+		}
+	}
+	return tags, types
+}()
+
+// collationKey reduces locale to the parts that select collation rules: the base
+// language, its script when not the default one, and a supported -u-co or -u-va type.
+// The region is kept only when it names its own rules ("en-US-u-va-posix"); it and
+// private-use subtags and other extensions are otherwise dropped, so "en-GB" and
+// "en-x-a1" both become "en" while "zh-TW" becomes "zh-Hant".
+func collationKey(locale language.Tag) language.Tag {
+	base, _ := locale.Base()
+	script, _ := locale.Script()
+	if defaultScript, _ := language.Make(base.String()).Script(); script == defaultScript {
+		script = language.Script{}
+	}
+
+	key, err := language.Compose(base, script)
+	if err != nil {
+		return DefaultLocale
+	}
+	for _, name := range []string{"co", "va"} {
+		value := locale.TypeForKey(name)
+		if !collationTypes[name+"-"+value] {
+			continue
+		}
+		if typed, err := key.SetTypeForKey(name, value); err == nil {
+			key = typed
+		}
+	}
+
+	if region, confidence := locale.Region(); confidence == language.Exact {
+		if regional, err := language.Compose(key, region); err == nil && supportedTags[regional.String()] {
+			return regional
+		}
+	}
+	return key
+}
+
+// ParseLocale parses a BCP 47 locale such as "en", "de" or "sv" for collation and
+// returns its collation key (see collationKey), e.g. "en" for "en-GB".
+// Returns an error if raw is not a well-formed language tag or no collation
+// rules exist for its language.
+func ParseLocale(raw string) (language.Tag, error) {
+	locale, err := language.Parse(raw)
+	if err != nil {
+		return language.Und, err
+	}
+	if _, _, confidence := supportedLocales.Match(locale); confidence == language.No {
+		return language.Und, fmt.Errorf("no collation rules for locale %q", raw)
+	}
+	return collationKey(locale), nil
+}
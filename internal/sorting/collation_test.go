@@ -0,0 +1,48 @@
+package sorting
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{raw: "en", want: "en"},
+		{raw: "sv", want: "sv"},
+		{raw: "en_us", want: "en-US"},
+		{raw: "en-GB", want: "en"},
+		{raw: "en-x-a1", want: "en"},
+		{raw: "zh-TW", want: "zh-Hant"},
+		{raw: "de-u-co-phonebk", want: "de-u-co-phonebk"},
+		{raw: "de-u-co-unknown", want: "de"},
+		{raw: "en-!!", wantErr: true},
+		{raw: "klingon", wantErr: true},
+		{raw: "not_a_locale", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := ParseLocale(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLocale(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("ParseLocale(%q) = %s, want %s", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollatorPoolSharedAcrossTagVariants(t *testing.T) {
+	want := collatorPool(language.MustParse("sv"))
+	for _, raw := range []string{"sv-SE", "sv-x-a1", "sv-x-b2", "sv-u-ka-shifted"} {
+		if got := collatorPool(language.MustParse(raw)); got != want {
+			t.Errorf("collatorPool(%s) is not the pool of sv", raw)
+		}
+	}
+}
@@ -2,38 +2,82 @@ package sorting
 
 import "github.com/stressedbypull/swapi-connector/internal/domain"
 
+// Sortable fields per entity, named as in the JSON response (except "episode").
+// Add a field here and it becomes sortable, validated and documented everywhere.
+var (
+	PersonSorters = NewRegistry[domain.Person]().
+			Register("name", ByName[domain.Person]{}).
+			Register("created", ByCreated[domain.Person]{}).
+			Register("mass", ByMass{}).
+			Register("relevance", ByRelevance[domain.Person]{})
+
+	PlanetSorters = NewRegistry[domain.Planet]().
+			Register("name", ByName[domain.Planet]{}).
+			Register("created", ByCreated[domain.Planet]{}).
+			Register("population", ByPopulation{}).
+			Register("diameter", ByDiameter{}).
+			Register("relevance", ByRelevance[domain.Planet]{})
+
+	FilmSorters = NewRegistry[domain.Film]().
+			Register("title", ByName[domain.Film]{}).
+			Register("created", ByCreated[domain.Film]{}).
+			Register("episode", ByEpisode{}).
+			Register("releaseDate", ByReleaseDate{})
+
+	StarshipSorters = NewRegistry[domain.Starship]().
+			Register("name", ByName[domain.Starship]{}).
+			Register("created", ByCreated[domain.Starship]{}).
+			Register("costInCredits", ByCost[domain.Starship]{}).
+			Register("length", ByLength[domain.Starship]{}).
+			Register("maxAtmospheringSpeed", ByMaxSpeed[domain.Starship]{}).
+			Register("hyperdriveRating", ByHyperdrive{})
+
+	VehicleSorters = NewRegistry[domain.Vehicle]().
+			Register("name", ByName[domain.Vehicle]{}).
+			Register("created", ByCreated[domain.Vehicle]{}).
+			Register("costInCredits", ByCost[domain.Vehicle]{}).
+			Register("length", ByLength[domain.Vehicle]{}).
+			Register("maxAtmospheringSpeed", ByMaxSpeed[domain.Vehicle]{})
+
+	SpeciesSorters = NewRegistry[domain.Species]().
+			Register("name", ByName[domain.Species]{}).
+			Register("created", ByCreated[domain.Species]{}).
+			Register("averageHeight", ByAverageHeight{}).
+			Register("averageLifespan", ByAverageLifespan{})
+)
+
 // NewPersonSorter creates a sorter for Person entities based on the field name.
 // Returns nil if the field is not supported.
 func NewPersonSorter(field string) Sorter[domain.Person] {
-	switch field {
-	case "name":
-		return ByName[domain.Person]{}
-	case "created":
-		return ByCreated[domain.Person]{}
-	case "mass":
-		// Mass is Person-specific, but we wrap it to match the interface
-		return personMassAdapter{}
-	default:
-		return nil
-	}
+	return PersonSorters.Sorter(field)
 }
 
 // NewPlanetSorter creates a sorter for Planet entities based on the field name.
 // Returns nil if the field is not supported.
 func NewPlanetSorter(field string) Sorter[domain.Planet] {
-	switch field {
-	case "name":
-		return ByName[domain.Planet]{}
-	case "created":
-		return ByCreated[domain.Planet]{}
-	default:
-		return nil
-	}
+	return PlanetSorters.Sorter(field)
 }
 
-// personMassAdapter adapts ByMass to the generic Sorter interface.
-type personMassAdapter struct{}
+// NewFilmSorter creates a sorter for Film entities based on the field name.
+// Returns nil if the field is not supported.
+func NewFilmSorter(field string) Sorter[domain.Film] {
+	return FilmSorters.Sorter(field)
+}
 
-func (a personMassAdapter) Sort(people []domain.Person, ascending bool) {
-	ByMass{}.Sort(people, ascending)
+// NewStarshipSorter creates a sorter for Starship entities based on the field name.
+// Returns nil if the field is not supported.
+func NewStarshipSorter(field string) Sorter[domain.Starship] {
+	return StarshipSorters.Sorter(field)
+}
+
+// NewVehicleSorter creates a sorter for Vehicle entities based on the field name.
+// Returns nil if the field is not supported.
+func NewVehicleSorter(field string) Sorter[domain.Vehicle] {
+	return VehicleSorters.Sorter(field)
+}
+
+// NewSpeciesSorter creates a sorter for Species entities based on the field name.
+// Returns nil if the field is not supported.
+func NewSpeciesSorter(field string) Sorter[domain.Species] {
+	return SpeciesSorters.Sorter(field)
 }
@@ -25,6 +25,11 @@ func TestNewPersonSorter(t *testing.T) {
 			field:   "mass",
 			wantNil: false,
 		},
+		{
+			name:    "relevance sorter",
+			field:   "relevance",
+			wantNil: false,
+		},
 		{
 			name:    "unknown field returns nil",
 			field:   "unknown",
@@ -70,6 +75,16 @@ func TestNewPlanetSorter(t *testing.T) {
 			field:   "created",
 			wantNil: false,
 		},
+		{
+			name:    "population sorter",
+			field:   "population",
+			wantNil: false,
+		},
+		{
+			name:    "diameter sorter",
+			field:   "diameter",
+			wantNil: false,
+		},
 		{
 			name:    "mass not supported for planets",
 			field:   "mass",
@@ -98,3 +113,102 @@ func TestNewPlanetSorter(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFilmSorter(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   string
+		wantNil bool
+	}{
+		{
+			name:    "title sorter",
+			field:   "title",
+			wantNil: false,
+		},
+		{
+			name:    "created sorter",
+			field:   "created",
+			wantNil: false,
+		},
+		{
+			name:    "episode sorter",
+			field:   "episode",
+			wantNil: false,
+		},
+		{
+			name:    "releaseDate sorter",
+			field:   "releaseDate",
+			wantNil: false,
+		},
+		{
+			name:    "mass not supported for films",
+			field:   "mass",
+			wantNil: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorter := NewFilmSorter(tt.field)
+
+			if tt.wantNil {
+				if sorter != nil {
+					t.Errorf("NewFilmSorter(%q) = %v, want nil", tt.field, sorter)
+				}
+			} else {
+				if sorter == nil {
+					t.Errorf("NewFilmSorter(%q) = nil, want non-nil", tt.field)
+				}
+			}
+		})
+	}
+}
+
+func TestNewStarshipAndVehicleSorter(t *testing.T) {
+	tests := []struct {
+		name            string
+		field           string
+		wantStarshipNil bool
+		wantVehicleNil  bool
+	}{
+		{name: "name sorter", field: "name"},
+		{name: "cost sorter", field: "costInCredits"},
+		{name: "length sorter", field: "length"},
+		{name: "max speed sorter", field: "maxAtmospheringSpeed"},
+		{name: "hyperdrive only on starships", field: "hyperdriveRating", wantVehicleNil: true},
+		{name: "unknown field returns nil", field: "mass", wantStarshipNil: true, wantVehicleNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewStarshipSorter(tt.field); (got == nil) != tt.wantStarshipNil {
+				t.Errorf("NewStarshipSorter(%q) = %v, wantNil %v", tt.field, got, tt.wantStarshipNil)
+			}
+			if got := NewVehicleSorter(tt.field); (got == nil) != tt.wantVehicleNil {
+				t.Errorf("NewVehicleSorter(%q) = %v, wantNil %v", tt.field, got, tt.wantVehicleNil)
+			}
+		})
+	}
+}
+
+func TestNewSpeciesSorter(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   string
+		wantNil bool
+	}{
+		{name: "name sorter", field: "name"},
+		{name: "created sorter", field: "created"},
+		{name: "average height sorter", field: "averageHeight"},
+		{name: "average lifespan sorter", field: "averageLifespan"},
+		{name: "mass not supported for species", field: "mass", wantNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewSpeciesSorter(tt.field); (got == nil) != tt.wantNil {
+				t.Errorf("NewSpeciesSorter(%q) = %v, wantNil %v", tt.field, got, tt.wantNil)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package sorting
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Registry maps the sortable field names of one entity type to their sorters.
+// It is the single source of truth for sorting: the factories, the handlers'
+// allow-lists and the enums advertised in the OpenAPI docs are all derived from it.
+type Registry[T Sortable] struct {
+	fields  []string // Registration order, used for allow-lists and docs
+	sorters map[string]Sorter[T]
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry[T Sortable]() *Registry[T] {
+	return &Registry[T]{sorters: make(map[string]Sorter[T])}
+}
+
+// Register adds a sortable field. Sorters of fields whose values can be unknown
+// should implement Nullable so that ?nulls= applies to them.
+// Registering a field twice is a programming error and panics.
+func (r *Registry[T]) Register(field string, sorter Sorter[T]) *Registry[T] {
+	if _, exists := r.sorters[field]; exists {
+		panic(fmt.Sprintf("sorting: field %q registered twice", field))
+	}
+	r.fields = append(r.fields, field)
+	r.sorters[field] = sorter
+	return r
+}
+
+// Sorter returns the sorter for field, or nil if the field is not registered.
+func (r *Registry[T]) Sorter(field string) Sorter[T] {
+	return r.sorters[field]
+}
+
+// Fields returns the registered field names in registration order.
+func (r *Registry[T]) Fields() []string {
+	return slices.Clone(r.fields)
+}
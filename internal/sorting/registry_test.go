@@ -0,0 +1,40 @@
+package sorting
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+)
+
+func TestRegistry(t *testing.T) {
+	registry := NewRegistry[domain.Person]().
+		Register("mass", ByMass{}).
+		Register("name", ByName[domain.Person]{})
+
+	if got, want := registry.Fields(), []string{"mass", "name"}; !slices.Equal(got, want) {
+		t.Errorf("Fields() = %v, want %v", got, want)
+	}
+
+	if registry.Sorter("height") != nil {
+		t.Error("expected nil sorter for an unregistered field")
+	}
+
+	people := []domain.Person{{Name: "Darth Vader", Mass: domain.Measure(136)}, {Name: "Yoda", Mass: domain.Measure(17)}, {Name: "Luke Skywalker", Mass: domain.Measure(77)}}
+	registry.Sorter("mass").Sort(people, false)
+	if people[0].Name != "Darth Vader" || people[2].Name != "Yoda" {
+		t.Errorf("registered sorter sorted %v, want heaviest first", people)
+	}
+}
+
+func TestRegistry_DuplicateFieldPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected Register to panic on a duplicate field")
+		}
+	}()
+
+	NewRegistry[domain.Person]().
+		Register("mass", ByMass{}).
+		Register("mass", ByMass{})
+}
@@ -1,6 +1,13 @@
 package sorting
 
-import "time"
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/stressedbypull/swapi-connector/internal/domain"
+	"golang.org/x/text/language"
+)
 
 // Sortable defines entities that can be sorted by common fields.
 type Sortable interface {
@@ -8,9 +15,85 @@ type Sortable interface {
 	GetCreated() time.Time
 }
 
+// Craft defines starships and vehicles that can be sorted by their numeric specs.
+type Craft interface {
+	Sortable
+	GetCostInCredits() domain.Measurement
+	GetLength() domain.Measurement
+	GetMaxAtmospheringSpeed() domain.Measurement
+}
+
+// Scored defines search results that carry a relevance score.
+type Scored interface {
+	Sortable
+	GetRelevance() float64
+}
+
 // Sorter defines the interface for sorting strategies (Open-Closed Principle).
 // New sorting strategies can be added without modifying existing code.
 // Generic interface works with any Sortable type.
 type Sorter[T Sortable] interface {
+	// Sort sorts items in place. The sort is stable: equal items keep their order.
 	Sort(items []T, ascending bool)
+	// Compare returns a negative number when a sorts before b in ascending order,
+	// a positive number when after and 0 when they are equal for this field.
+	Compare(a, b T) int
+}
+
+// Nullable is implemented by sorters of numeric fields whose values can be unknown.
+// Unknown values are placed first or last (see domain.NullsPlacement) in either
+// direction instead of being reversed along with the known ones.
+type Nullable[T Sortable] interface {
+	// Known reports whether the item has a value for the sorted field.
+	Known(item T) bool
+}
+
+// Collated is implemented by sorters of text fields that collate by locale.
+type Collated[T Sortable] interface {
+	// WithLocale returns a copy of the sorter collating for locale.
+	WithLocale(locale language.Tag) Sorter[T]
+}
+
+// sortStable sorts items by sorter, reversed for descending order, with unknown values last.
+// Equal items keep their original order.
+func sortStable[T Sortable](items []T, sorter Sorter[T], ascending bool) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		return compareDirected(sorter, ascending, domain.NullsLast, a, b)
+	})
+}
+
+// compareDirected compares a and b by sorter, reversed for descending order.
+// If the sorter is Nullable, unknown values are placed by nulls whatever the direction.
+func compareDirected[T Sortable](sorter Sorter[T], ascending bool, nulls domain.NullsPlacement, a, b T) int {
+	if nullable, ok := sorter.(Nullable[T]); ok {
+		aKnown, bKnown := nullable.Known(a), nullable.Known(b)
+		switch {
+		case !aKnown && !bKnown:
+			return 0
+		case aKnown != bKnown:
+			// Exactly one is unknown: it goes first only when nulls=first
+			if aKnown == (nulls == domain.NullsFirst) {
+				return 1
+			}
+			return -1
+		}
+	}
+
+	if ascending {
+		return sorter.Compare(a, b)
+	}
+	return sorter.Compare(b, a)
+}
+
+// compareMeasurements orders known values ascending, followed by unknown values.
+func compareMeasurements(a, b domain.Measurement) int {
+	switch {
+	case a.Known && b.Known:
+		return cmp.Compare(a.Value, b.Value)
+	case a.Known:
+		return -1
+	case b.Known:
+		return 1
+	}
+	return 0
 }